@@ -33,6 +33,12 @@ const (
 	AviLoadBalancerControllerIPAM AviLoadBalancerIPAMType = "controller"
 )
 
+const (
+	// AviLoadBalancerDefaultCloudName is the default value of
+	// AviLoadBalancerConfigSpec.CloudName.
+	AviLoadBalancerDefaultCloudName = "Default-Cloud"
+)
+
 // AviLoadBalancerConfigSpec defines the configuration for an Avi load balancer.
 // This specification is used to configure the resources the Avi Kubernetes
 // Operator (AKO) requires in order to connect to the Avi load balancer.
@@ -99,7 +105,12 @@ type AviLoadBalancerConfigStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=avilbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Server",type="string",JSONPath=".spec.server"
+// +kubebuilder:printcolumn:name="Cloud",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="IPAM",type="string",JSONPath=".spec.ipamType"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // AviLoadBalancerConfig is the Schema for the AviLoadBalancerConfigs API
 type AviLoadBalancerConfig struct {
@@ -0,0 +1,138 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-aviloadbalancerconfig,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=aviloadbalancerconfigs,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=maviloadbalancerconfig.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-aviloadbalancerconfig,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=aviloadbalancerconfigs,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vaviloadbalancerconfig.netoperator.vmware.com
+
+// Default sets the default values of an AviLoadBalancerConfig.
+func (c *AviLoadBalancerConfig) Default() {
+	if c.Spec.CloudName == "" {
+		c.Spec.CloudName = AviLoadBalancerDefaultCloudName
+	}
+	if c.Spec.AdvancedL4 == nil {
+		advancedL4 := true
+		c.Spec.AdvancedL4 = &advancedL4
+	}
+	if c.Spec.LogLevel == "" {
+		c.Spec.LogLevel = AviLoadBalancerLogLevelWarn
+	}
+	if c.Spec.IPAMType == "" {
+		c.Spec.IPAMType = AviLoadBalancerControllerIPAM
+	}
+	c.Spec.CredentialSecretRef.Default()
+}
+
+// ValidateCreate validates an AviLoadBalancerConfig on creation.
+func (c *AviLoadBalancerConfig) ValidateCreate() error {
+	return invalid("AviLoadBalancerConfig", c.Name, c.validate())
+}
+
+// ValidateUpdate validates an AviLoadBalancerConfig on update.
+func (c *AviLoadBalancerConfig) ValidateUpdate(old runtime.Object) error {
+	return invalid("AviLoadBalancerConfig", c.Name, c.validate())
+}
+
+// ValidateDelete validates an AviLoadBalancerConfig on deletion.
+func (c *AviLoadBalancerConfig) ValidateDelete() error {
+	return nil
+}
+
+func (c *AviLoadBalancerConfig) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if msg := validateAviServer(c.Spec.Server); msg != "" {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("server"), c.Spec.Server, msg))
+	}
+
+	switch c.Spec.LogLevel {
+	case "", AviLoadBalancerLogLevelInfo, AviLoadBalancerLogLevelDebug,
+		AviLoadBalancerLogLevelWarn, AviLoadBalancerLogLevelError:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("logLevel"), c.Spec.LogLevel,
+			[]string{string(AviLoadBalancerLogLevelInfo), string(AviLoadBalancerLogLevelDebug),
+				string(AviLoadBalancerLogLevelWarn), string(AviLoadBalancerLogLevelError)}))
+	}
+
+	switch c.Spec.IPAMType {
+	case "", AviLoadBalancerControllerIPAM, AviLoadBalancerSupervisorIPAM:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("ipamType"), c.Spec.IPAMType,
+			[]string{string(AviLoadBalancerControllerIPAM), string(AviLoadBalancerSupervisorIPAM)}))
+	}
+
+	allErrs = append(allErrs, validateRequired(c.Spec.CredentialSecretRef.Name,
+		specPath.Child("credentialSecretRef", "name"))...)
+	return allErrs
+}
+
+// validateAviServer validates s against the [SCHEME://]ADDRESS[:PORT] format
+// of AviLoadBalancerConfigSpec.Server. It returns a description of the
+// problem, or an empty string if s is valid. IPv6 addresses must be enclosed
+// in brackets when a port is specified.
+func validateAviServer(s string) string {
+	if s == "" {
+		return "must not be empty"
+	}
+
+	rest := s
+	if i := strings.Index(rest, "://"); i >= 0 {
+		switch rest[:i] {
+		case "http", "https":
+		default:
+			return "scheme must be http or https"
+		}
+		rest = rest[i+3:]
+	}
+	if strings.ContainsAny(rest, "/?#@") {
+		return "must have the format [SCHEME://]ADDRESS[:PORT]"
+	}
+
+	host, port, hasPort := rest, "", false
+	switch {
+	case strings.HasPrefix(rest, "["):
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return "missing ']' in address"
+		}
+		host = rest[1:end]
+		if tail := rest[end+1:]; tail != "" {
+			if !strings.HasPrefix(tail, ":") {
+				return "must have the format [SCHEME://]ADDRESS[:PORT]"
+			}
+			port, hasPort = tail[1:], true
+		}
+		if ip := net.ParseIP(host); ip == nil || ip.To4() != nil {
+			return "address in brackets must be an IPv6 address"
+		}
+	case strings.Count(rest, ":") == 1:
+		i := strings.Index(rest, ":")
+		host, port, hasPort = rest[:i], rest[i+1:], true
+	}
+
+	if host == "" {
+		return "address must not be empty"
+	}
+	if net.ParseIP(host) == nil && len(validation.IsDNS1123Subdomain(host)) > 0 {
+		return "address must be an IP address or a DNS name"
+	}
+	if hasPort {
+		// ParseUint rejects the empty port of "host:" and signed ports.
+		if n, err := strconv.ParseUint(port, 10, 16); err != nil || n < 1 {
+			return "port must be a number between 1 and 65535"
+		}
+	}
+	return ""
+}
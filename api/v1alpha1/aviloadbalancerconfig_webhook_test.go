@@ -0,0 +1,77 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestValidateAviServer(t *testing.T) {
+	tests := []struct {
+		server string
+		valid  bool
+	}{
+		{"avi.example.com", true},
+		{"avi.example.com:443", true},
+		{"https://avi.example.com:8443", true},
+		{"http://10.0.0.1", true},
+		{"10.0.0.1:1", true},
+		{"10.0.0.1:65535", true},
+		{"fd00::1", true},
+		{"[fd00::1]", true},
+		{"[fd00::1]:443", true},
+		{"https://[fd00::1]:443", true},
+		{"", false},
+		{"ftp://avi.example.com", false},
+		{"https://avi.example.com/api", false},
+		{"user@avi.example.com", false},
+		{"avi.example.com:", false},
+		{"[fd00::1]:", false},
+		{"avi.example.com:0", false},
+		{"avi.example.com:65536", false},
+		{"avi.example.com:-1", false},
+		{"avi.example.com:+443", false},
+		{"avi.example.com:https", false},
+		{"[fd00::1", false},
+		{"[10.0.0.1]:443", false},
+		{"[fd00::1]443", false},
+		{":443", false},
+		{"Avi_Example", false},
+	}
+	for _, tt := range tests {
+		msg := validateAviServer(tt.server)
+		if valid := msg == ""; valid != tt.valid {
+			t.Errorf("validateAviServer(%q) = %q, want valid %v", tt.server, msg, tt.valid)
+		}
+	}
+}
+
+func TestAviLoadBalancerConfigValidate(t *testing.T) {
+	c := &AviLoadBalancerConfig{
+		Spec: AviLoadBalancerConfigSpec{
+			Server:   "avi.example.com:",
+			LogLevel: "TRACE",
+			IPAMType: "Other",
+		},
+	}
+	errs := c.validate()
+	want := []string{"spec.server", "spec.logLevel", "spec.ipamType", "spec.credentialSecretRef.name"}
+	if len(errs) != len(want) {
+		t.Fatalf("validate() = %v, want errors for %v", errs, want)
+	}
+	for i, err := range errs {
+		if err.Field != want[i] {
+			t.Errorf("validate()[%d].Field = %q, want %q", i, err.Field, want[i])
+		}
+	}
+
+	c.Spec.Server = "https://avi.example.com"
+	c.Spec.LogLevel = ""
+	c.Spec.IPAMType = ""
+	c.Spec.CredentialSecretRef.Name = "avi-credentials"
+	c.Default()
+	if err := c.ValidateCreate(); err != nil {
+		t.Errorf("ValidateCreate() = %v, want nil", err)
+	}
+}
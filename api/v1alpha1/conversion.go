@@ -0,0 +1,769 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+)
+
+// ConversionDataAnnotation is the annotation used to preserve the values of fields that cannot be
+// represented in the version an object is converted to, so that converting the object back to its
+// original version is lossless. The annotation is removed when the object is converted back.
+const ConversionDataAnnotation = "netoperator.vmware.com/conversion-data"
+
+// setConversionData stores data as the value of the ConversionDataAnnotation of obj.
+func setConversionData(obj metav1.Object, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ConversionDataAnnotation] = string(b)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// getConversionData decodes the value of the ConversionDataAnnotation of obj into data and
+// removes the annotation from obj. It returns false if obj does not have the annotation.
+func getConversionData(obj metav1.Object, data interface{}) (bool, error) {
+	annotations := obj.GetAnnotations()
+	value, ok := annotations[ConversionDataAnnotation]
+	if !ok {
+		return false, nil
+	}
+	delete(annotations, ConversionDataAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+	if err := json.Unmarshal([]byte(value), data); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// subnetMaskToPrefixLength returns the prefix length of the given subnet mask. It returns 0 if
+// the mask is not a valid subnet mask.
+func subnetMaskToPrefixLength(mask string) int32 {
+	ip := net.ParseIP(mask)
+	if ip == nil {
+		return 0
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	ones, _ := net.IPMask(ip).Size()
+	return int32(ones)
+}
+
+// prefixLengthToSubnetMask returns the subnet mask of the given family with the given prefix
+// length. It returns an empty string if the family is unknown or the prefix length is out of
+// range for the family.
+func prefixLengthToSubnetMask(prefixLength int32, family corev1.IPFamily) string {
+	var bits int
+	switch family {
+	case corev1.IPv4Protocol:
+		bits = 8 * net.IPv4len
+	case corev1.IPv6Protocol:
+		bits = 8 * net.IPv6len
+	default:
+		return ""
+	}
+	if prefixLength < 0 || int(prefixLength) > bits {
+		return ""
+	}
+	return net.IP(net.CIDRMask(int(prefixLength), bits)).String()
+}
+
+// ConvertTo converts this AviLoadBalancerConfig to the hub version.
+func (src *AviLoadBalancerConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.AviLoadBalancerConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.AviLoadBalancerConfigSpec{
+		Server:              src.Spec.Server,
+		CloudName:           src.Spec.CloudName,
+		AdvancedL4:          src.Spec.AdvancedL4,
+		LogLevel:            v1alpha2.AviLoadBalancerLogLevel(src.Spec.LogLevel),
+		IPAMType:            v1alpha2.AviLoadBalancerIPAMType(src.Spec.IPAMType),
+		CredentialSecretRef: v1alpha2.ClientSecretReference(src.Spec.CredentialSecretRef),
+	}
+	dst.Status = v1alpha2.AviLoadBalancerConfigStatus{}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this AviLoadBalancerConfig.
+func (dst *AviLoadBalancerConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.AviLoadBalancerConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = AviLoadBalancerConfigSpec{
+		Server:              src.Spec.Server,
+		CloudName:           src.Spec.CloudName,
+		AdvancedL4:          src.Spec.AdvancedL4,
+		LogLevel:            AviLoadBalancerLogLevel(src.Spec.LogLevel),
+		IPAMType:            AviLoadBalancerIPAMType(src.Spec.IPAMType),
+		CredentialSecretRef: ClientSecretReference(src.Spec.CredentialSecretRef),
+	}
+	dst.Status = AviLoadBalancerConfigStatus{}
+	return nil
+}
+
+// ConvertTo converts this E1000ENetworkInterface to the hub version.
+func (src *E1000ENetworkInterface) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.E1000ENetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.E1000ENetworkInterfaceSpec(src.Spec)
+	dst.Status = v1alpha2.E1000ENetworkInterfaceStatus{}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this E1000ENetworkInterface.
+func (dst *E1000ENetworkInterface) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.E1000ENetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = E1000ENetworkInterfaceSpec(src.Spec)
+	dst.Status = E1000ENetworkInterfaceStatus{}
+	return nil
+}
+
+// ConvertTo converts this HAProxyLoadBalancerConfig to the hub version.
+func (src *HAProxyLoadBalancerConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.HAProxyLoadBalancerConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.HAProxyLoadBalancerConfigSpec{
+		EndPointURLs:        src.Spec.EndPointURLs,
+		ServerName:          src.Spec.ServerName,
+		CredentialSecretRef: v1alpha2.ClientSecretReference(src.Spec.CredentialSecretRef),
+	}
+	dst.Status = v1alpha2.HAProxyLoadBalancerConfigStatus{}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this HAProxyLoadBalancerConfig.
+func (dst *HAProxyLoadBalancerConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.HAProxyLoadBalancerConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = HAProxyLoadBalancerConfigSpec{
+		EndPointURLs:        src.Spec.EndPointURLs,
+		ServerName:          src.Spec.ServerName,
+		CredentialSecretRef: ClientSecretReference(src.Spec.CredentialSecretRef),
+	}
+	dst.Status = HAProxyLoadBalancerConfigStatus{}
+	return nil
+}
+
+// ConvertTo converts this IPPool to the hub version.
+func (src *IPPool) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.IPPool)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.IPPoolSpec{
+		CIDRs: src.Spec.CIDRs,
+	}
+	// The hub has no startingAddress and addressCount, their range is the first of the ranges.
+	if src.Spec.StartingAddress != "" || src.Spec.AddressCount != 0 {
+		dst.Spec.Ranges = append(dst.Spec.Ranges, legacyIPRange(src.Spec.StartingAddress, src.Spec.AddressCount))
+	}
+	for _, r := range src.Spec.Ranges {
+		dst.Spec.Ranges = append(dst.Spec.Ranges, v1alpha2.IPRange(r))
+	}
+	for _, r := range src.Spec.Exclusions {
+		dst.Spec.Exclusions = append(dst.Spec.Exclusions, v1alpha2.IPRange(r))
+	}
+	if sa := src.Spec.StickyAllocation; sa != nil {
+		dst.Spec.StickyAllocation = &v1alpha2.StickyAllocation{
+			IdentityLabel: sa.IdentityLabel,
+			GracePeriod:   sa.GracePeriod,
+		}
+	}
+	dst.Status = v1alpha2.IPPoolStatus{
+		AddressCount:   src.Status.AddressCount,
+		AllocatedCount: src.Status.AllocatedCount,
+		FreeCount:      src.Status.FreeCount,
+		HeldCount:      src.Status.HeldCount,
+	}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1alpha2.IPPoolCondition{
+			Type:    v1alpha2.IPPoolConditionType(c.Type),
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	for _, a := range src.Status.Allocations {
+		dst.Status.Allocations = append(dst.Status.Allocations, v1alpha2.IPPoolAllocation{
+			IP:                  a.IP,
+			NetworkInterfaceRef: v1alpha2.NetworkInterfaceReference(a.NetworkInterfaceRef),
+			Identity:            a.Identity,
+		})
+	}
+	for _, h := range src.Status.Held {
+		dst.Status.Held = append(dst.Status.Held, v1alpha2.IPPoolHeldAddress(h))
+	}
+
+	if src.Spec.StartingAddress != "" || src.Spec.AddressCount != 0 {
+		return setConversionData(dst, &IPPool{Spec: IPPoolSpec{
+			StartingAddress: src.Spec.StartingAddress,
+			AddressCount:    src.Spec.AddressCount,
+		}})
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this IPPool.
+func (dst *IPPool) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.IPPool)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = IPPoolSpec{
+		CIDRs: src.Spec.CIDRs,
+	}
+	for _, r := range src.Spec.Ranges {
+		dst.Spec.Ranges = append(dst.Spec.Ranges, IPRange(r))
+	}
+	for _, r := range src.Spec.Exclusions {
+		dst.Spec.Exclusions = append(dst.Spec.Exclusions, IPRange(r))
+	}
+	if sa := src.Spec.StickyAllocation; sa != nil {
+		dst.Spec.StickyAllocation = &StickyAllocation{
+			IdentityLabel: sa.IdentityLabel,
+			GracePeriod:   sa.GracePeriod,
+		}
+	}
+	dst.Status = IPPoolStatus{
+		AddressCount:   src.Status.AddressCount,
+		AllocatedCount: src.Status.AllocatedCount,
+		FreeCount:      src.Status.FreeCount,
+		HeldCount:      src.Status.HeldCount,
+	}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, IPPoolCondition{
+			Type:    IPPoolConditionType(c.Type),
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	for _, a := range src.Status.Allocations {
+		dst.Status.Allocations = append(dst.Status.Allocations, IPPoolAllocation{
+			IP:                  a.IP,
+			NetworkInterfaceRef: NetworkInterfaceReference(a.NetworkInterfaceRef),
+			Identity:            a.Identity,
+		})
+	}
+	for _, h := range src.Status.Held {
+		dst.Status.Held = append(dst.Status.Held, IPPoolHeldAddress(h))
+	}
+
+	// Restore startingAddress and addressCount if the first range is still theirs.
+	restored := &IPPool{}
+	ok, err := getConversionData(dst, restored)
+	if err != nil || !ok {
+		return err
+	}
+	start, count := restored.Spec.StartingAddress, restored.Spec.AddressCount
+	if len(src.Spec.Ranges) > 0 && src.Spec.Ranges[0] == legacyIPRange(start, count) {
+		dst.Spec.StartingAddress = start
+		dst.Spec.AddressCount = count
+		dst.Spec.Ranges = dst.Spec.Ranges[1:]
+		if len(dst.Spec.Ranges) == 0 {
+			dst.Spec.Ranges = nil
+		}
+	}
+	return nil
+}
+
+// legacyIPRange returns the range of count addresses starting at start. The
+// range only contains start if count addresses starting at start are not a
+// valid range.
+func legacyIPRange(start string, count int64) v1alpha2.IPRange {
+	r := v1alpha2.IPRange{Start: start}
+	if sp, family, err := countSpan(start, count); err == nil {
+		r.End = ipaddr.FromInt(sp.last, family).String()
+	}
+	return r
+}
+
+// ConvertTo converts this LoadBalancerConfig to the hub version.
+func (src *LoadBalancerConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.LoadBalancerConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.LoadBalancerConfigSpec{
+		Type: v1alpha2.LoadBalancerConfigType(src.Spec.Type),
+		ProviderRef: v1alpha2.ProviderReference{
+			APIGroup:   src.Spec.ProviderRef.APIGroup,
+			Kind:       src.Spec.ProviderRef.Kind,
+			Name:       src.Spec.ProviderRef.Name,
+			APIVersion: src.Spec.ProviderRef.APIVersion,
+		},
+	}
+	dst.Status = v1alpha2.LoadBalancerConfigStatus{}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1alpha2.LoadBalancerConfigCondition{
+			Type:               v1alpha2.LoadBalancerConfigConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+
+	restored := &v1alpha2.LoadBalancerConfig{}
+	if ok, err := getConversionData(dst, restored); err != nil || !ok {
+		return err
+	}
+	if ref := restored.Spec.ProviderRef; sameProviderRef(ref, dst.Spec.ProviderRef) {
+		dst.Spec.ProviderRef.Namespace = ref.Namespace
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this LoadBalancerConfig.
+func (dst *LoadBalancerConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.LoadBalancerConfig)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = LoadBalancerConfigSpec{
+		Type: LoadBalancerConfigType(src.Spec.Type),
+		ProviderRef: LoadBalancerConfigProviderReference{
+			APIGroup:   src.Spec.ProviderRef.APIGroup,
+			Kind:       src.Spec.ProviderRef.Kind,
+			Name:       src.Spec.ProviderRef.Name,
+			APIVersion: src.Spec.ProviderRef.APIVersion,
+		},
+	}
+	dst.Status = LoadBalancerConfigStatus{}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, LoadBalancerConfigCondition{
+			Type:               LoadBalancerConfigConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+
+	// v1alpha1 load balancer provider references have no namespace.
+	if src.Spec.ProviderRef.Namespace != "" {
+		return setConversionData(dst, &v1alpha2.LoadBalancerConfig{Spec: src.Spec})
+	}
+	return nil
+}
+
+// ConvertTo converts this Network to the hub version.
+func (src *Network) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.Network)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.NetworkSpec{
+		Type:             v1alpha2.NetworkType(src.Spec.Type),
+		ProviderRef:      v1alpha2.ProviderReference(src.Spec.ProviderRef),
+		DNS:              src.Spec.DNS,
+		DNSSearchDomains: src.Spec.DNSSearchDomains,
+		NTP:              src.Spec.NTP,
+	}
+	dst.Status = v1alpha2.NetworkStatus{}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this Network.
+func (dst *Network) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.Network)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = NetworkSpec{
+		Type:             NetworkType(src.Spec.Type),
+		ProviderRef:      NetworkProviderReference(src.Spec.ProviderRef),
+		DNS:              src.Spec.DNS,
+		DNSSearchDomains: src.Spec.DNSSearchDomains,
+		NTP:              src.Spec.NTP,
+	}
+	dst.Status = NetworkStatus{}
+	return nil
+}
+
+// ConvertTo converts this NetworkInterface to the hub version.
+func (src *NetworkInterface) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.NetworkInterfaceSpec{
+		NetworkName: src.Spec.NetworkName,
+		Type:        v1alpha2.NetworkInterfaceType(src.Spec.Type),
+	}
+	if ref := src.Spec.ProviderRef; ref != nil {
+		dst.Spec.ProviderRef = &v1alpha2.ProviderReference{
+			APIGroup:   ref.APIGroup,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			APIVersion: ref.APIVersion,
+		}
+	}
+	if pa := src.Spec.PortAllocation; pa != nil {
+		dst.Spec.PortAllocation = &v1alpha2.NetworkInterfacePortAllocation{NodeName: pa.NodeName}
+	}
+	for _, c := range src.Spec.RequestedIPConfigs {
+		dst.Spec.RequestedIPConfigs = append(dst.Spec.RequestedIPConfigs, v1alpha2.RequestedIPConfig(c))
+	}
+	if src.Spec.DefaultRoute != nil {
+		defaultRoute := *src.Spec.DefaultRoute
+		dst.Spec.DefaultRoute = &defaultRoute
+	}
+	dst.Status = v1alpha2.NetworkInterfaceStatus{
+		MacAddress:   src.Status.MacAddress,
+		ExternalID:   src.Status.ExternalID,
+		NetworkID:    src.Status.NetworkID,
+		PortID:       src.Status.PortID,
+		ConnectionID: src.Status.ConnectionID,
+	}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1alpha2.NetworkInterfaceCondition{
+			Type:               v1alpha2.NetworkInterfaceConditionType(c.Type),
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             v1alpha2.NetworkInterfaceConditionReason(c.Reason),
+			Message:            c.Message,
+		})
+	}
+	for _, c := range src.Status.IPConfigs {
+		dst.Status.IPConfigs = append(dst.Status.IPConfigs, v1alpha2.IPConfig{
+			IP:           c.IP,
+			IPFamily:     c.IPFamily,
+			Gateway:      c.Gateway,
+			PrefixLength: subnetMaskToPrefixLength(c.SubnetMask),
+			Routes:       routesToHub(c.Routes),
+			Nameservers:  c.Nameservers,
+		})
+	}
+
+	restored := &v1alpha2.NetworkInterface{}
+	ok, err := getConversionData(dst, restored)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if ref := restored.Spec.ProviderRef; ref != nil && dst.Spec.ProviderRef != nil &&
+			sameProviderRef(*ref, *dst.Spec.ProviderRef) {
+			dst.Spec.ProviderRef.Namespace = ref.Namespace
+		}
+		for i := range dst.Status.IPConfigs {
+			if i >= len(restored.Status.IPConfigs) {
+				break
+			}
+			c := restored.Status.IPConfigs[i]
+			if c.IP == src.Status.IPConfigs[i].IP && c.IPFamily == src.Status.IPConfigs[i].IPFamily &&
+				prefixLengthToSubnetMask(c.PrefixLength, c.IPFamily) == src.Status.IPConfigs[i].SubnetMask {
+				dst.Status.IPConfigs[i].PrefixLength = c.PrefixLength
+			}
+		}
+	}
+
+	// Preserve the subnet masks that do not survive the conversion to a prefix length and back.
+	for i, c := range src.Status.IPConfigs {
+		if prefixLengthToSubnetMask(dst.Status.IPConfigs[i].PrefixLength, c.IPFamily) != c.SubnetMask {
+			return setConversionData(dst, &NetworkInterface{Status: NetworkInterfaceStatus{IPConfigs: src.Status.IPConfigs}})
+		}
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this NetworkInterface.
+func (dst *NetworkInterface) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = NetworkInterfaceSpec{
+		NetworkName: src.Spec.NetworkName,
+		Type:        NetworkInterfaceType(src.Spec.Type),
+	}
+	// lossy is true if a value of the hub version cannot be represented in v1alpha1.
+	lossy := false
+	if ref := src.Spec.ProviderRef; ref != nil {
+		dst.Spec.ProviderRef = &NetworkInterfaceProviderReference{
+			APIGroup:   ref.APIGroup,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			APIVersion: ref.APIVersion,
+		}
+		lossy = lossy || ref.Namespace != ""
+	}
+	if pa := src.Spec.PortAllocation; pa != nil {
+		dst.Spec.PortAllocation = &NetworkInterfacePortAllocation{NodeName: pa.NodeName}
+	}
+	for _, c := range src.Spec.RequestedIPConfigs {
+		dst.Spec.RequestedIPConfigs = append(dst.Spec.RequestedIPConfigs, RequestedIPConfig(c))
+	}
+	if src.Spec.DefaultRoute != nil {
+		defaultRoute := *src.Spec.DefaultRoute
+		dst.Spec.DefaultRoute = &defaultRoute
+	}
+	dst.Status = NetworkInterfaceStatus{
+		MacAddress:   src.Status.MacAddress,
+		ExternalID:   src.Status.ExternalID,
+		NetworkID:    src.Status.NetworkID,
+		PortID:       src.Status.PortID,
+		ConnectionID: src.Status.ConnectionID,
+	}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, NetworkInterfaceCondition{
+			Type:               NetworkInterfaceConditionType(c.Type),
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             NetworkInterfaceConditionReason(c.Reason),
+			Message:            c.Message,
+		})
+	}
+	for _, c := range src.Status.IPConfigs {
+		dst.Status.IPConfigs = append(dst.Status.IPConfigs, IPConfig{
+			IP:          c.IP,
+			IPFamily:    c.IPFamily,
+			Gateway:     c.Gateway,
+			SubnetMask:  prefixLengthToSubnetMask(c.PrefixLength, c.IPFamily),
+			Routes:      routesFromHub(c.Routes),
+			Nameservers: c.Nameservers,
+		})
+	}
+
+	restored := &NetworkInterface{}
+	ok, err := getConversionData(dst, restored)
+	if err != nil {
+		return err
+	}
+	if ok {
+		for i := range dst.Status.IPConfigs {
+			if i >= len(restored.Status.IPConfigs) {
+				break
+			}
+			c := restored.Status.IPConfigs[i]
+			if c.IP == src.Status.IPConfigs[i].IP && c.IPFamily == src.Status.IPConfigs[i].IPFamily &&
+				subnetMaskToPrefixLength(c.SubnetMask) == src.Status.IPConfigs[i].PrefixLength {
+				dst.Status.IPConfigs[i].SubnetMask = c.SubnetMask
+			}
+		}
+	}
+
+	for i, c := range src.Status.IPConfigs {
+		if subnetMaskToPrefixLength(dst.Status.IPConfigs[i].SubnetMask) != c.PrefixLength {
+			lossy = true
+		}
+	}
+	if lossy {
+		return setConversionData(dst, &v1alpha2.NetworkInterface{Spec: src.Spec, Status: src.Status})
+	}
+	return nil
+}
+
+// ConvertTo converts this NSXTNetwork to the hub version.
+func (src *NSXTNetwork) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NSXTNetwork)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.NSXTNetworkSpec{
+		Tier1Router: src.Spec.Tier1Router,
+		SegmentPath: src.Spec.SegmentPath,
+		SubnetCIDRs: append([]string(nil), src.Spec.SubnetCIDRs...),
+		SNATIP:      src.Spec.SNATIP,
+	}
+	dst.Status = v1alpha2.NSXTNetworkStatus{}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1alpha2.NSXTNetworkCondition{
+			Type:               v1alpha2.NSXTNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this NSXTNetwork.
+func (dst *NSXTNetwork) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NSXTNetwork)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = NSXTNetworkSpec{
+		Tier1Router: src.Spec.Tier1Router,
+		SegmentPath: src.Spec.SegmentPath,
+		SubnetCIDRs: append([]string(nil), src.Spec.SubnetCIDRs...),
+		SNATIP:      src.Spec.SNATIP,
+	}
+	dst.Status = NSXTNetworkStatus{}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, NSXTNetworkCondition{
+			Type:               NSXTNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return nil
+}
+
+// ConvertTo converts this SRIOVNetworkInterface to the hub version.
+func (src *SRIOVNetworkInterface) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.SRIOVNetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.SRIOVNetworkInterfaceSpec(src.Spec)
+	dst.Status = v1alpha2.SRIOVNetworkInterfaceStatus{}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this SRIOVNetworkInterface.
+func (dst *SRIOVNetworkInterface) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.SRIOVNetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = SRIOVNetworkInterfaceSpec(src.Spec)
+	dst.Status = SRIOVNetworkInterfaceStatus{}
+	return nil
+}
+
+// ConvertTo converts this VMXNET3NetworkInterface to the hub version.
+func (src *VMXNET3NetworkInterface) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.VMXNET3NetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.VMXNET3NetworkInterfaceSpec(src.Spec)
+	dst.Status = v1alpha2.VMXNET3NetworkInterfaceStatus{}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this VMXNET3NetworkInterface.
+func (dst *VMXNET3NetworkInterface) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.VMXNET3NetworkInterface)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = VMXNET3NetworkInterfaceSpec(src.Spec)
+	dst.Status = VMXNET3NetworkInterfaceStatus{}
+	return nil
+}
+
+// ConvertTo converts this VSphereDistributedNetwork to the hub version.
+func (src *VSphereDistributedNetwork) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.VSphereDistributedNetwork)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = v1alpha2.VSphereDistributedNetworkSpec{
+		PortGroupID:      src.Spec.PortGroupID,
+		IPAssignmentMode: v1alpha2.IPAssignmentModeType(src.Spec.IPAssignmentMode),
+		Gateway:          src.Spec.Gateway,
+		SubnetMask:       src.Spec.SubnetMask,
+		Routes:           routesToHub(src.Spec.Routes),
+		MTU:              src.Spec.MTU,
+	}
+	for _, ref := range src.Spec.IPPools {
+		dst.Spec.IPPools = append(dst.Spec.IPPools, v1alpha2.IPPoolReference(ref))
+	}
+	if c := src.Spec.PortGroupCreation; c != nil {
+		creation := v1alpha2.PortGroupCreation(*c)
+		dst.Spec.PortGroupCreation = &creation
+	}
+	if src.Spec.VLANID != nil {
+		vlanID := *src.Spec.VLANID
+		dst.Spec.VLANID = &vlanID
+	}
+	for _, r := range src.Spec.VLANTrunkRanges {
+		dst.Spec.VLANTrunkRanges = append(dst.Spec.VLANTrunkRanges, v1alpha2.VLANRange(r))
+	}
+	if s := src.Spec.Security; s != nil {
+		security := v1alpha2.PortGroupSecurity(*s.DeepCopy())
+		dst.Spec.Security = &security
+	}
+	if v6 := src.Spec.IPv6; v6 != nil {
+		dst.Spec.IPv6 = &v1alpha2.IPv6Config{
+			IPAssignmentMode: v1alpha2.IPAssignmentModeType(v6.IPAssignmentMode),
+			Gateway:          v6.Gateway,
+			PrefixLength:     v6.PrefixLength,
+		}
+		for _, ref := range v6.IPPools {
+			dst.Spec.IPv6.IPPools = append(dst.Spec.IPv6.IPPools, v1alpha2.IPPoolReference(ref))
+		}
+	}
+	dst.Status = v1alpha2.VSphereDistributedNetworkStatus{}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1alpha2.VSphereDistributedNetworkCondition{
+			Type:               v1alpha2.VSphereDistributedNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this VSphereDistributedNetwork.
+func (dst *VSphereDistributedNetwork) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.VSphereDistributedNetwork)
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = VSphereDistributedNetworkSpec{
+		PortGroupID:      src.Spec.PortGroupID,
+		IPAssignmentMode: IPAssignmentModeType(src.Spec.IPAssignmentMode),
+		Gateway:          src.Spec.Gateway,
+		SubnetMask:       src.Spec.SubnetMask,
+		Routes:           routesFromHub(src.Spec.Routes),
+		MTU:              src.Spec.MTU,
+	}
+	for _, ref := range src.Spec.IPPools {
+		dst.Spec.IPPools = append(dst.Spec.IPPools, IPPoolReference(ref))
+	}
+	if c := src.Spec.PortGroupCreation; c != nil {
+		creation := PortGroupCreation(*c)
+		dst.Spec.PortGroupCreation = &creation
+	}
+	if src.Spec.VLANID != nil {
+		vlanID := *src.Spec.VLANID
+		dst.Spec.VLANID = &vlanID
+	}
+	for _, r := range src.Spec.VLANTrunkRanges {
+		dst.Spec.VLANTrunkRanges = append(dst.Spec.VLANTrunkRanges, VLANRange(r))
+	}
+	if s := src.Spec.Security; s != nil {
+		security := PortGroupSecurity(*s.DeepCopy())
+		dst.Spec.Security = &security
+	}
+	if v6 := src.Spec.IPv6; v6 != nil {
+		dst.Spec.IPv6 = &IPv6Config{
+			IPAssignmentMode: IPAssignmentModeType(v6.IPAssignmentMode),
+			Gateway:          v6.Gateway,
+			PrefixLength:     v6.PrefixLength,
+		}
+		for _, ref := range v6.IPPools {
+			dst.Spec.IPv6.IPPools = append(dst.Spec.IPv6.IPPools, IPPoolReference(ref))
+		}
+	}
+	dst.Status = VSphereDistributedNetworkStatus{}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, VSphereDistributedNetworkCondition{
+			Type:               VSphereDistributedNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return nil
+}
+
+// routesToHub converts routes to the hub version.
+func routesToHub(routes []Route) []v1alpha2.Route {
+	var out []v1alpha2.Route
+	for _, r := range routes {
+		out = append(out, v1alpha2.Route(r))
+	}
+	return out
+}
+
+// routesFromHub converts routes from the hub version.
+func routesFromHub(routes []v1alpha2.Route) []Route {
+	var out []Route
+	for _, r := range routes {
+		out = append(out, Route(r))
+	}
+	return out
+}
+
+// sameProviderRef returns true if a and b refer to the same object, ignoring their namespaces.
+func sameProviderRef(a, b v1alpha2.ProviderReference) bool {
+	return a.APIGroup == b.APIGroup && a.Kind == b.Kind && a.Name == b.Name && a.APIVersion == b.APIVersion
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// fuzzIterations is the number of objects of each kind converted by the
+// round-trip tests.
+const fuzzIterations = 200
+
+func newFuzzer(t *testing.T, seed int64) *fuzz.Fuzzer {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, conversionFuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+}
+
+// conversionFuzzerFuncs keeps the IP families of fuzzed objects valid, the
+// families are used to convert subnet masks to prefix lengths.
+func conversionFuzzerFuncs(_ serializer.CodecFactory) []interface{} {
+	families := []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+	return []interface{}{
+		func(c *IPConfig, f fuzz.Continue) {
+			f.FuzzNoCustom(c)
+			c.IPFamily = families[f.Intn(len(families))]
+		},
+		func(c *v1alpha2.IPConfig, f fuzz.Continue) {
+			f.FuzzNoCustom(c)
+			c.IPFamily = families[f.Intn(len(families))]
+		},
+		func(m *metav1.ObjectMeta, f fuzz.Continue) {
+			f.FuzzNoCustom(m)
+			delete(m.Annotations, ConversionDataAnnotation)
+		},
+	}
+}
+
+type convertible interface {
+	conversion.Convertible
+	metav1.Object
+}
+
+// spokes returns an empty object of every kind together with an empty object
+// of its hub.
+func spokes() map[string]struct {
+	spoke convertible
+	hub   conversion.Hub
+} {
+	return map[string]struct {
+		spoke convertible
+		hub   conversion.Hub
+	}{
+		"AviLoadBalancerConfig":     {&AviLoadBalancerConfig{}, &v1alpha2.AviLoadBalancerConfig{}},
+		"E1000ENetworkInterface":    {&E1000ENetworkInterface{}, &v1alpha2.E1000ENetworkInterface{}},
+		"HAProxyLoadBalancerConfig": {&HAProxyLoadBalancerConfig{}, &v1alpha2.HAProxyLoadBalancerConfig{}},
+		"IPPool":                    {&IPPool{}, &v1alpha2.IPPool{}},
+		"LoadBalancerConfig":        {&LoadBalancerConfig{}, &v1alpha2.LoadBalancerConfig{}},
+		"Network":                   {&Network{}, &v1alpha2.Network{}},
+		"NetworkInterface":          {&NetworkInterface{}, &v1alpha2.NetworkInterface{}},
+		"NSXTNetwork":               {&NSXTNetwork{}, &v1alpha2.NSXTNetwork{}},
+		"SRIOVNetworkInterface":     {&SRIOVNetworkInterface{}, &v1alpha2.SRIOVNetworkInterface{}},
+		"VMXNET3NetworkInterface":   {&VMXNET3NetworkInterface{}, &v1alpha2.VMXNET3NetworkInterface{}},
+		"VSphereDistributedNetwork": {&VSphereDistributedNetwork{}, &v1alpha2.VSphereDistributedNetwork{}},
+	}
+}
+
+// newObject returns a new empty object of the type of obj.
+func newObject(obj interface{}) interface{} {
+	return reflect.New(reflect.TypeOf(obj).Elem()).Interface()
+}
+
+func TestFuzzSpokeHubSpoke(t *testing.T) {
+	for kind, tt := range spokes() {
+		f := newFuzzer(t, 1)
+		for i := 0; i < fuzzIterations; i++ {
+			spoke := newObject(tt.spoke).(convertible)
+			f.Fuzz(spoke)
+			hub := newObject(tt.hub).(conversion.Hub)
+			if err := spoke.ConvertTo(hub); err != nil {
+				t.Fatalf("%s: ConvertTo() = %v", kind, err)
+			}
+			converted := newObject(tt.spoke).(convertible)
+			if err := converted.ConvertFrom(hub); err != nil {
+				t.Fatalf("%s: ConvertFrom() = %v", kind, err)
+			}
+			if !equality.Semantic.DeepEqual(spoke, converted) {
+				t.Fatalf("%s: v1alpha1 -> v1alpha2 -> v1alpha1 is lossy:\n%s",
+					kind, diff.ObjectReflectDiff(spoke, converted))
+			}
+		}
+	}
+}
+
+func TestFuzzHubSpokeHub(t *testing.T) {
+	for kind, tt := range spokes() {
+		f := newFuzzer(t, 1)
+		for i := 0; i < fuzzIterations; i++ {
+			hub := newObject(tt.hub).(conversion.Hub)
+			f.Fuzz(hub)
+			spoke := newObject(tt.spoke).(convertible)
+			if err := spoke.ConvertFrom(hub); err != nil {
+				t.Fatalf("%s: ConvertFrom() = %v", kind, err)
+			}
+			converted := newObject(tt.hub).(conversion.Hub)
+			if err := spoke.ConvertTo(converted); err != nil {
+				t.Fatalf("%s: ConvertTo() = %v", kind, err)
+			}
+			if !equality.Semantic.DeepEqual(hub, converted) {
+				t.Fatalf("%s: v1alpha2 -> v1alpha1 -> v1alpha2 is lossy:\n%s",
+					kind, diff.ObjectReflectDiff(hub, converted))
+			}
+		}
+	}
+}
+
+func TestConvertSubnetMaskToPrefixLength(t *testing.T) {
+	tests := []struct {
+		name       string
+		subnetMask string
+		family     corev1.IPFamily
+		prefix     int32
+		annotated  bool
+	}{
+		{"IPv4 mask", "255.255.255.0", corev1.IPv4Protocol, 24, false},
+		{"IPv6 mask", "ffff:ffff:ffff:ffff::", corev1.IPv6Protocol, 64, false},
+		{"non-canonical IPv6 mask", "FFFF:FFFF:FFFF:FFFF:0:0:0:0", corev1.IPv6Protocol, 64, true},
+		{"non-contiguous mask", "255.0.255.0", corev1.IPv4Protocol, 0, true},
+		{"no mask", "", corev1.IPv4Protocol, 0, true},
+	}
+	for _, tt := range tests {
+		ni := &NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "ni"},
+			Status: NetworkInterfaceStatus{IPConfigs: []IPConfig{{
+				IP:         "192.168.1.10",
+				IPFamily:   tt.family,
+				SubnetMask: tt.subnetMask,
+			}}},
+		}
+		hub := &v1alpha2.NetworkInterface{}
+		if err := ni.ConvertTo(hub); err != nil {
+			t.Fatalf("%s: ConvertTo() = %v", tt.name, err)
+		}
+		if got := hub.Status.IPConfigs[0].PrefixLength; got != tt.prefix {
+			t.Errorf("%s: PrefixLength = %d, want %d", tt.name, got, tt.prefix)
+		}
+		if _, annotated := hub.Annotations[ConversionDataAnnotation]; annotated != tt.annotated {
+			t.Errorf("%s: conversion data annotation present = %v, want %v", tt.name, annotated, tt.annotated)
+		}
+
+		converted := &NetworkInterface{}
+		if err := converted.ConvertFrom(hub); err != nil {
+			t.Fatalf("%s: ConvertFrom() = %v", tt.name, err)
+		}
+		if got := converted.Status.IPConfigs[0].SubnetMask; got != tt.subnetMask {
+			t.Errorf("%s: restored SubnetMask = %q, want %q", tt.name, got, tt.subnetMask)
+		}
+		if _, ok := converted.Annotations[ConversionDataAnnotation]; ok {
+			t.Errorf("%s: conversion data annotation was not removed", tt.name)
+		}
+	}
+}
+
+func TestConvertSubnetMaskRestoredOnlyForUnchangedIPConfig(t *testing.T) {
+	ni := &NetworkInterface{
+		Status: NetworkInterfaceStatus{IPConfigs: []IPConfig{{
+			IP:         "192.168.1.10",
+			IPFamily:   corev1.IPv4Protocol,
+			SubnetMask: "255.0.255.0",
+		}}},
+	}
+	hub := &v1alpha2.NetworkInterface{}
+	if err := ni.ConvertTo(hub); err != nil {
+		t.Fatal(err)
+	}
+
+	// A client of the hub version changes the prefix length, the stale
+	// subnet mask of the annotation must not be restored.
+	hub.Status.IPConfigs[0].PrefixLength = 16
+	converted := &NetworkInterface{}
+	if err := converted.ConvertFrom(hub); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := converted.Status.IPConfigs[0].SubnetMask, "255.255.0.0"; got != want {
+		t.Errorf("SubnetMask = %q, want %q", got, want)
+	}
+}
+
+func TestConvertPrefixLengthToSubnetMask(t *testing.T) {
+	hub := &v1alpha2.NetworkInterface{
+		Status: v1alpha2.NetworkInterfaceStatus{IPConfigs: []v1alpha2.IPConfig{
+			{IP: "192.168.1.10", IPFamily: corev1.IPv4Protocol, PrefixLength: 24},
+			{IP: "fd00::10", IPFamily: corev1.IPv6Protocol, PrefixLength: 200},
+		}},
+	}
+	ni := &NetworkInterface{}
+	if err := ni.ConvertFrom(hub); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ni.Status.IPConfigs[0].SubnetMask, "255.255.255.0"; got != want {
+		t.Errorf("SubnetMask = %q, want %q", got, want)
+	}
+	// The prefix length of the IPv6 address has no subnet mask, it is kept
+	// in the annotation.
+	if got := ni.Status.IPConfigs[1].SubnetMask; got != "" {
+		t.Errorf("SubnetMask = %q, want empty", got)
+	}
+	if _, ok := ni.Annotations[ConversionDataAnnotation]; !ok {
+		t.Fatal("conversion data annotation not set")
+	}
+
+	converted := &v1alpha2.NetworkInterface{}
+	if err := ni.ConvertTo(converted); err != nil {
+		t.Fatal(err)
+	}
+	if !equality.Semantic.DeepEqual(hub, converted) {
+		t.Errorf("v1alpha2 -> v1alpha1 -> v1alpha2 is lossy:\n%s", diff.ObjectReflectDiff(hub, converted))
+	}
+}
+
+func TestConvertProviderRefNamespace(t *testing.T) {
+	ref := v1alpha2.ProviderReference{
+		APIGroup:  GroupName,
+		Kind:      "VMXNET3NetworkInterface",
+		Name:      "provider",
+		Namespace: "provider-namespace",
+	}
+
+	hubNI := &v1alpha2.NetworkInterface{Spec: v1alpha2.NetworkInterfaceSpec{ProviderRef: &ref}}
+	ni := &NetworkInterface{}
+	if err := ni.ConvertFrom(hubNI); err != nil {
+		t.Fatal(err)
+	}
+	convertedNI := &v1alpha2.NetworkInterface{}
+	if err := ni.ConvertTo(convertedNI); err != nil {
+		t.Fatal(err)
+	}
+	if got := convertedNI.Spec.ProviderRef.Namespace; got != ref.Namespace {
+		t.Errorf("NetworkInterface ProviderRef.Namespace = %q, want %q", got, ref.Namespace)
+	}
+
+	// The namespace is dropped when the reference is changed in v1alpha1.
+	ni.Spec.ProviderRef.Name = "other"
+	if err := ni.ConvertTo(convertedNI); err != nil {
+		t.Fatal(err)
+	}
+	if got := convertedNI.Spec.ProviderRef.Namespace; got != "" {
+		t.Errorf("NetworkInterface ProviderRef.Namespace of a changed reference = %q, want empty", got)
+	}
+
+	lbRef := ref
+	lbRef.Kind = "HAProxyLoadBalancerConfig"
+	hubLB := &v1alpha2.LoadBalancerConfig{Spec: v1alpha2.LoadBalancerConfigSpec{ProviderRef: lbRef}}
+	lb := &LoadBalancerConfig{}
+	if err := lb.ConvertFrom(hubLB); err != nil {
+		t.Fatal(err)
+	}
+	convertedLB := &v1alpha2.LoadBalancerConfig{}
+	if err := lb.ConvertTo(convertedLB); err != nil {
+		t.Fatal(err)
+	}
+	if got := convertedLB.Spec.ProviderRef.Namespace; got != ref.Namespace {
+		t.Errorf("LoadBalancerConfig ProviderRef.Namespace = %q, want %q", got, ref.Namespace)
+	}
+	if _, ok := convertedLB.Annotations[ConversionDataAnnotation]; ok {
+		t.Error("conversion data annotation was not removed")
+	}
+}
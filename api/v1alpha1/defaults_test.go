@@ -0,0 +1,127 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetworkInterfaceDefault(t *testing.T) {
+	ni := &NetworkInterface{
+		Spec: NetworkInterfaceSpec{
+			RequestedIPConfigs: []RequestedIPConfig{{IP: "192.168.1.10"}, {IP: "fd00::10"}},
+		},
+	}
+	ni.Default()
+	if ni.Spec.Type != NetworkInterfaceTypeVMXNet3 {
+		t.Errorf("Type = %q, want %q", ni.Spec.Type, NetworkInterfaceTypeVMXNet3)
+	}
+	for i, want := range []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol} {
+		if got := ni.Spec.RequestedIPConfigs[i].IPFamily; got != want {
+			t.Errorf("RequestedIPConfigs[%d].IPFamily = %q, want %q", i, got, want)
+		}
+	}
+	if err := ni.ValidateCreate(); err != nil {
+		t.Errorf("ValidateCreate() = %v, want nil", err)
+	}
+
+	// Values that are set are kept.
+	ni = &NetworkInterface{Spec: NetworkInterfaceSpec{Type: NetworkInterfaceTypeE1000E}}
+	ni.Default()
+	if ni.Spec.Type != NetworkInterfaceTypeE1000E {
+		t.Errorf("Type = %q, want %q", ni.Spec.Type, NetworkInterfaceTypeE1000E)
+	}
+}
+
+func TestVSphereDistributedNetworkDefault(t *testing.T) {
+	n := &VSphereDistributedNetwork{Spec: VSphereDistributedNetworkSpec{IPv6: &IPv6Config{}}}
+	n.Default()
+	if n.Spec.IPAssignmentMode != IPAssignmentModeStaticPool {
+		t.Errorf("IPAssignmentMode = %q, want %q", n.Spec.IPAssignmentMode, IPAssignmentModeStaticPool)
+	}
+	if n.Spec.IPv6.IPAssignmentMode != IPAssignmentModeStaticPool {
+		t.Errorf("IPv6.IPAssignmentMode = %q, want %q", n.Spec.IPv6.IPAssignmentMode, IPAssignmentModeStaticPool)
+	}
+	if n.Spec.IPv6.PrefixLength != DefaultIPv6PrefixLength {
+		t.Errorf("IPv6.PrefixLength = %d, want %d", n.Spec.IPv6.PrefixLength, DefaultIPv6PrefixLength)
+	}
+
+	n = &VSphereDistributedNetwork{
+		Spec: VSphereDistributedNetworkSpec{
+			IPAssignmentMode: IPAssignmentModeDHCP,
+			IPv6:             &IPv6Config{IPAssignmentMode: IPAssignmentModeSLAAC, PrefixLength: 56},
+		},
+	}
+	n.Default()
+	if n.Spec.IPAssignmentMode != IPAssignmentModeDHCP || n.Spec.IPv6.IPAssignmentMode != IPAssignmentModeSLAAC ||
+		n.Spec.IPv6.PrefixLength != 56 {
+		t.Errorf("Default() changed the values that are set: %+v, %+v", n.Spec, *n.Spec.IPv6)
+	}
+}
+
+func TestAviLoadBalancerConfigDefault(t *testing.T) {
+	c := &AviLoadBalancerConfig{}
+	c.Default()
+	if c.Spec.CloudName != AviLoadBalancerDefaultCloudName {
+		t.Errorf("CloudName = %q, want %q", c.Spec.CloudName, AviLoadBalancerDefaultCloudName)
+	}
+	if c.Spec.AdvancedL4 == nil || !*c.Spec.AdvancedL4 {
+		t.Errorf("AdvancedL4 = %v, want true", c.Spec.AdvancedL4)
+	}
+	if c.Spec.LogLevel != AviLoadBalancerLogLevelWarn {
+		t.Errorf("LogLevel = %q, want %q", c.Spec.LogLevel, AviLoadBalancerLogLevelWarn)
+	}
+	if c.Spec.IPAMType != AviLoadBalancerControllerIPAM {
+		t.Errorf("IPAMType = %q, want %q", c.Spec.IPAMType, AviLoadBalancerControllerIPAM)
+	}
+	if c.Spec.CredentialSecretRef.Namespace != ClientSecretDefaultNamespace {
+		t.Errorf("CredentialSecretRef.Namespace = %q, want %q",
+			c.Spec.CredentialSecretRef.Namespace, ClientSecretDefaultNamespace)
+	}
+
+	// An explicit false is not defaulted.
+	advancedL4 := false
+	c = &AviLoadBalancerConfig{Spec: AviLoadBalancerConfigSpec{AdvancedL4: &advancedL4}}
+	c.Default()
+	if *c.Spec.AdvancedL4 {
+		t.Errorf("AdvancedL4 = true, want the explicit false")
+	}
+}
+
+func TestHAProxyLoadBalancerConfigDefault(t *testing.T) {
+	c := &HAProxyLoadBalancerConfig{}
+	c.Default()
+	if c.Spec.CredentialSecretRef.Namespace != ClientSecretDefaultNamespace {
+		t.Errorf("CredentialSecretRef.Namespace = %q, want %q",
+			c.Spec.CredentialSecretRef.Namespace, ClientSecretDefaultNamespace)
+	}
+	c.Spec.CredentialSecretRef.Namespace = "other"
+	c.Default()
+	if c.Spec.CredentialSecretRef.Namespace != "other" {
+		t.Errorf("CredentialSecretRef.Namespace = %q, want other", c.Spec.CredentialSecretRef.Namespace)
+	}
+}
+
+func TestIPPoolDefault(t *testing.T) {
+	p := &IPPool{Spec: IPPoolSpec{StickyAllocation: &StickyAllocation{}}}
+	p.Default()
+	if d := p.Spec.StickyAllocation.GracePeriod.Duration; d != DefaultStickyAllocationGracePeriod {
+		t.Errorf("StickyAllocation.GracePeriod = %v, want %v", d, DefaultStickyAllocationGracePeriod)
+	}
+	p.Spec.StickyAllocation.GracePeriod = metav1.Duration{Duration: time.Minute}
+	p.Default()
+	if d := p.Spec.StickyAllocation.GracePeriod.Duration; d != time.Minute {
+		t.Errorf("StickyAllocation.GracePeriod = %v, want 1m", d)
+	}
+
+	p = &IPPool{}
+	p.Default()
+	if p.Spec.StickyAllocation != nil {
+		t.Errorf("StickyAllocation = %+v, want nil when sticky allocation is disabled", p.Spec.StickyAllocation)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-e1000enetworkinterface,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=e1000enetworkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=me1000enetworkinterface.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-e1000enetworkinterface,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=e1000enetworkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=ve1000enetworkinterface.netoperator.vmware.com
+
+// Default sets the default values of an E1000ENetworkInterface. E1000ENetworkInterface has no fields with
+// default values.
+func (e *E1000ENetworkInterface) Default() {
+}
+
+// ValidateCreate validates an E1000ENetworkInterface on creation. All values
+// of E1000ENetworkInterfaceSpec are valid.
+func (e *E1000ENetworkInterface) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates an E1000ENetworkInterface on update. All values
+// of E1000ENetworkInterfaceSpec are valid.
+func (e *E1000ENetworkInterface) ValidateUpdate(old runtime.Object) error {
+	return nil
+}
+
+// ValidateDelete validates an E1000ENetworkInterface on deletion.
+func (e *E1000ENetworkInterface) ValidateDelete() error {
+	return nil
+}
@@ -67,7 +67,11 @@ type HAProxyLoadBalancerConfigStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=haproxylbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Endpoints",type="string",JSONPath=".spec.endPointURLs"
+// +kubebuilder:printcolumn:name="Server Name",type="string",JSONPath=".spec.serverName",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // HAProxyLoadBalancerConfig is the Schema for the HAProxyLoadBalancerConfigs API
 type HAProxyLoadBalancerConfig struct {
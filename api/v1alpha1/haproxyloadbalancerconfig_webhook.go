@@ -0,0 +1,55 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-haproxyloadbalancerconfig,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=haproxyloadbalancerconfigs,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mhaproxyloadbalancerconfig.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-haproxyloadbalancerconfig,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=haproxyloadbalancerconfigs,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vhaproxyloadbalancerconfig.netoperator.vmware.com
+
+// Default sets the default values of a HAProxyLoadBalancerConfig.
+func (c *HAProxyLoadBalancerConfig) Default() {
+	c.Spec.CredentialSecretRef.Default()
+}
+
+// ValidateCreate validates a HAProxyLoadBalancerConfig on creation.
+func (c *HAProxyLoadBalancerConfig) ValidateCreate() error {
+	return invalid("HAProxyLoadBalancerConfig", c.Name, c.validate())
+}
+
+// ValidateUpdate validates a HAProxyLoadBalancerConfig on update.
+func (c *HAProxyLoadBalancerConfig) ValidateUpdate(old runtime.Object) error {
+	return invalid("HAProxyLoadBalancerConfig", c.Name, c.validate())
+}
+
+// ValidateDelete validates a HAProxyLoadBalancerConfig on deletion.
+func (c *HAProxyLoadBalancerConfig) ValidateDelete() error {
+	return nil
+}
+
+func (c *HAProxyLoadBalancerConfig) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	urlsPath := field.NewPath("spec", "endPointURLs")
+
+	if len(c.Spec.EndPointURLs) == 0 {
+		allErrs = append(allErrs, field.Required(urlsPath, "at least one DataPlane API endpoint is required"))
+	}
+	for i, s := range c.Spec.EndPointURLs {
+		u, err := url.Parse(s)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, field.Invalid(urlsPath.Index(i), s, err.Error()))
+		case u.Scheme != "http" && u.Scheme != "https":
+			allErrs = append(allErrs, field.Invalid(urlsPath.Index(i), s, "scheme must be http or https"))
+		case u.Host == "":
+			allErrs = append(allErrs, field.Invalid(urlsPath.Index(i), s, "must include a host"))
+		}
+	}
+	return allErrs
+}
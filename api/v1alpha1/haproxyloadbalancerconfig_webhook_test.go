@@ -0,0 +1,33 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestHAProxyLoadBalancerConfigValidate(t *testing.T) {
+	tests := []struct {
+		urls  []string
+		valid bool
+	}{
+		{[]string{"https://haproxy.example.com:5556/v2"}, true},
+		{[]string{"http://10.0.0.1:5556", "https://[fd00::1]:5556"}, true},
+		{nil, false},
+		{[]string{"haproxy.example.com:5556"}, false},
+		{[]string{"ftp://haproxy.example.com"}, false},
+		{[]string{"https://"}, false},
+		{[]string{"https://haproxy.example.com", "https://%zz"}, false},
+	}
+	for _, tt := range tests {
+		c := &HAProxyLoadBalancerConfig{
+			Spec: HAProxyLoadBalancerConfigSpec{EndPointURLs: tt.urls},
+		}
+		c.Spec.CredentialSecretRef.Name = "haproxy-credentials"
+		err := c.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("ValidateCreate() with endPointURLs %q = %v, want valid %v", tt.urls, err, tt.valid)
+		}
+	}
+}
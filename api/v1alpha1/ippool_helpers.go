@@ -0,0 +1,264 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultStickyAllocationGracePeriod is how long the addresses of deleted
+// NetworkInterfaces are held when the grace period of a sticky IPPool is
+// unset.
+const DefaultStickyAllocationGracePeriod = 30 * time.Minute
+
+const (
+	// IPPoolFullReasonExhausted is the reason set on a True IPPoolFull condition.
+	IPPoolFullReasonExhausted = "Exhausted"
+	// IPPoolFullReasonAvailable is the reason set on a False IPPoolFull condition.
+	IPPoolFullReasonAvailable = "Available"
+	// IPPoolFullReasonInvalidSpec is the reason set on an Unknown IPPoolFull
+	// condition when the spec of the pool does not describe valid addresses.
+	IPPoolFullReasonInvalidSpec = "InvalidSpec"
+)
+
+// GetAllocation returns the allocation of the given IP address, or nil if the
+// address is not allocated.
+func (p *IPPool) GetAllocation(ip string) *IPPoolAllocation {
+	for i := range p.Status.Allocations {
+		if sameIP(p.Status.Allocations[i].IP, ip) {
+			return &p.Status.Allocations[i]
+		}
+	}
+	return nil
+}
+
+// GetAllocationsFor returns the allocations owned by the given NetworkInterface.
+func (p *IPPool) GetAllocationsFor(ref NetworkInterfaceReference) []IPPoolAllocation {
+	var allocations []IPPoolAllocation
+	for _, a := range p.Status.Allocations {
+		if a.NetworkInterfaceRef.Matches(ref) {
+			allocations = append(allocations, a)
+		}
+	}
+	return allocations
+}
+
+// SetAllocation records the given IP address as allocated to the given
+// NetworkInterface, replacing the owner if the address is already allocated.
+// A hold of the address is removed. The allocation counts and the IPPoolFull
+// condition are updated to match.
+func (p *IPPool) SetAllocation(ip string, ref NetworkInterfaceReference) {
+	p.removeHeld(ip)
+	if a := p.GetAllocation(ip); a != nil {
+		a.NetworkInterfaceRef = ref
+		a.Identity = ""
+	} else {
+		p.Status.Allocations = append(p.Status.Allocations, IPPoolAllocation{
+			IP:                  ip,
+			NetworkInterfaceRef: ref,
+		})
+	}
+	p.UpdateAllocationStatus()
+}
+
+// RemoveAllocation removes the allocation of the given IP address and returns
+// true if the address was allocated. The allocation counts and the IPPoolFull
+// condition are updated to match.
+func (p *IPPool) RemoveAllocation(ip string) bool {
+	removed := false
+	allocations := p.Status.Allocations[:0]
+	for _, a := range p.Status.Allocations {
+		if sameIP(a.IP, ip) {
+			removed = true
+			continue
+		}
+		allocations = append(allocations, a)
+	}
+	p.Status.Allocations = allocations
+	p.UpdateAllocationStatus()
+	return removed
+}
+
+// RemoveAllocationsFor removes all allocations owned by the given
+// NetworkInterface and returns the number of allocations removed. The
+// allocation counts and the IPPoolFull condition are updated to match.
+func (p *IPPool) RemoveAllocationsFor(ref NetworkInterfaceReference) int {
+	removed := 0
+	allocations := p.Status.Allocations[:0]
+	for _, a := range p.Status.Allocations {
+		if a.NetworkInterfaceRef.Matches(ref) {
+			removed++
+			continue
+		}
+		allocations = append(allocations, a)
+	}
+	p.Status.Allocations = allocations
+	p.UpdateAllocationStatus()
+	return removed
+}
+
+// SetStickyAllocation records the given IP address as allocated to the given
+// NetworkInterface with the given sticky allocation identity, see
+// SetAllocation.
+func (p *IPPool) SetStickyAllocation(ip string, ref NetworkInterfaceReference, identity string) {
+	p.SetAllocation(ip, ref)
+	p.GetAllocation(ip).Identity = identity
+}
+
+// ReleaseAllocationsFor removes all allocations owned by the given
+// NetworkInterface and returns the number of allocations removed. The
+// addresses of sticky allocations are held for their identity until the
+// given expiration time. The allocation counts and the IPPoolFull condition
+// are updated to match.
+func (p *IPPool) ReleaseAllocationsFor(ref NetworkInterfaceReference, expiration metav1.Time) int {
+	for _, a := range p.GetAllocationsFor(ref) {
+		if a.Identity != "" {
+			p.Status.Held = append(p.Status.Held, IPPoolHeldAddress{
+				IP:             a.IP,
+				Identity:       a.Identity,
+				ExpirationTime: expiration,
+			})
+		}
+	}
+	return p.RemoveAllocationsFor(ref)
+}
+
+// GetHeld returns the address held for the given identity, or nil if no
+// address is held for it.
+func (p *IPPool) GetHeld(identity string) *IPPoolHeldAddress {
+	for i := range p.Status.Held {
+		if p.Status.Held[i].Identity == identity {
+			return &p.Status.Held[i]
+		}
+	}
+	return nil
+}
+
+// ExpireHeld frees the held addresses whose expiration time is not after now
+// and returns the number of addresses freed. The allocation counts and the
+// IPPoolFull condition are updated to match.
+func (p *IPPool) ExpireHeld(now metav1.Time) int {
+	expired := 0
+	held := p.Status.Held[:0]
+	for _, h := range p.Status.Held {
+		if !now.Before(&h.ExpirationTime) {
+			expired++
+			continue
+		}
+		held = append(held, h)
+	}
+	p.Status.Held = held
+	p.UpdateAllocationStatus()
+	return expired
+}
+
+// NextHeldExpiration returns the earliest expiration time of the held
+// addresses. The second return value is false if no address is held.
+func (p *IPPool) NextHeldExpiration() (metav1.Time, bool) {
+	var next metav1.Time
+	for i, h := range p.Status.Held {
+		if i == 0 || h.ExpirationTime.Before(&next) {
+			next = h.ExpirationTime
+		}
+	}
+	return next, len(p.Status.Held) > 0
+}
+
+// StickyAllocationGracePeriod returns how long the addresses of deleted
+// NetworkInterfaces are held, or zero if sticky allocation is disabled.
+func (p *IPPool) StickyAllocationGracePeriod() time.Duration {
+	sa := p.Spec.StickyAllocation
+	switch {
+	case sa == nil:
+		return 0
+	case sa.GracePeriod.Duration == 0:
+		return DefaultStickyAllocationGracePeriod
+	default:
+		return sa.GracePeriod.Duration
+	}
+}
+
+// removeHeld removes the hold of the given IP address.
+func (p *IPPool) removeHeld(ip string) {
+	held := p.Status.Held[:0]
+	for _, h := range p.Status.Held {
+		if !sameIP(h.IP, ip) {
+			held = append(held, h)
+		}
+	}
+	p.Status.Held = held
+}
+
+// UpdateAllocationStatus recomputes AddressCount from the spec, and
+// AllocatedCount, HeldCount and FreeCount from the lists of allocations and
+// held addresses, and sets the IPPoolFull condition accordingly. The
+// IPPoolFull condition is Unknown if the spec is invalid.
+func (p *IPPool) UpdateAllocationStatus() {
+	p.Status.AddressCount = p.Spec.Size()
+	p.Status.AllocatedCount = int64(len(p.Status.Allocations))
+	p.Status.HeldCount = int64(len(p.Status.Held))
+	p.Status.FreeCount = p.Status.AddressCount - p.Status.AllocatedCount - p.Status.HeldCount
+	if p.Status.FreeCount < 0 {
+		p.Status.FreeCount = 0
+	}
+
+	full := IPPoolCondition{
+		Type:    IPPoolFull,
+		Status:  corev1.ConditionFalse,
+		Reason:  IPPoolFullReasonAvailable,
+		Message: fmt.Sprintf("%d of %d addresses allocated", p.Status.AllocatedCount, p.Status.AddressCount),
+	}
+	if _, err := p.Spec.AddressRanges(); err != nil {
+		full.Status = corev1.ConditionUnknown
+		full.Reason = IPPoolFullReasonInvalidSpec
+		full.Message = err.Error()
+	} else if p.Status.FreeCount == 0 {
+		full.Status = corev1.ConditionTrue
+		full.Reason = IPPoolFullReasonExhausted
+	}
+
+	for i := range p.Status.Conditions {
+		if p.Status.Conditions[i].Type == IPPoolFull {
+			p.Status.Conditions[i] = full
+			return
+		}
+	}
+	p.Status.Conditions = append(p.Status.Conditions, full)
+}
+
+// Matches returns true if r and other refer to the same object. The UIDs are
+// only compared if both references have one.
+func (r NetworkInterfaceReference) Matches(other NetworkInterfaceReference) bool {
+	if r.APIGroup != other.APIGroup || r.Kind != other.Kind {
+		return false
+	}
+	if r.Namespace != other.Namespace || r.Name != other.Name {
+		return false
+	}
+	return r.UID == "" || other.UID == "" || r.UID == other.UID
+}
+
+// sameIP returns true if a and b are the same IP address. Addresses that do
+// not parse are compared as strings.
+func sameIP(a, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return a == b
+	}
+	return ipA.Equal(ipB)
+}
+
+// String returns the namespace and name of the referenced object, prefixed
+// with its kind if it is not a NetworkInterface.
+func (r NetworkInterfaceReference) String() string {
+	if r.Kind == "" {
+		return r.Namespace + "/" + r.Name
+	}
+	return r.Kind + " " + r.Namespace + "/" + r.Name
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetworkInterfaceReferenceMatches(t *testing.T) {
+	ni := NetworkInterfaceReference{Name: "name", Namespace: "ns", UID: "uid"}
+	claim := NetworkInterfaceReference{
+		APIGroup:  "ipam.cluster.x-k8s.io",
+		Kind:      "IPAddressClaim",
+		Name:      "name",
+		Namespace: "ns",
+		UID:       "uid",
+	}
+	tests := []struct {
+		a, b NetworkInterfaceReference
+		want bool
+	}{
+		{ni, ni, true},
+		{ni, NetworkInterfaceReference{Name: "name", Namespace: "ns"}, true},
+		{ni, NetworkInterfaceReference{Name: "name", Namespace: "ns", UID: "other"}, false},
+		{ni, NetworkInterfaceReference{Name: "name", Namespace: "other"}, false},
+		{claim, claim, true},
+		// A claim does not own the addresses of the NetworkInterface with
+		// the same namespace and name.
+		{ni, claim, false},
+		{claim, NetworkInterfaceReference{Kind: "IPAddressClaim", Name: "name", Namespace: "ns"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Matches(tt.b); got != tt.want {
+			t.Errorf("%+v.Matches(%+v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNetworkInterfaceReferenceString(t *testing.T) {
+	if got := (NetworkInterfaceReference{Name: "name", Namespace: "ns"}).String(); got != "ns/name" {
+		t.Errorf("String() = %q, want ns/name", got)
+	}
+	claim := NetworkInterfaceReference{Kind: "IPAddressClaim", Name: "name", Namespace: "ns"}
+	if got := claim.String(); got != "IPAddressClaim ns/name" {
+		t.Errorf("String() = %q, want IPAddressClaim ns/name", got)
+	}
+}
+
+func TestReleaseAllocationsFor(t *testing.T) {
+	pool := &IPPool{Spec: IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10}}
+	ref := NetworkInterfaceReference{Name: "ni", Namespace: "ns", UID: "uid"}
+	pool.SetStickyAllocation("192.168.1.10", ref, "ns/VirtualMachine/vm")
+	pool.SetAllocation("192.168.1.11", ref)
+
+	expiration := metav1.NewTime(time.Now().Add(time.Hour))
+	if n := pool.ReleaseAllocationsFor(ref, expiration); n != 2 {
+		t.Errorf("ReleaseAllocationsFor() = %d, want 2", n)
+	}
+	// Only the sticky allocation is held.
+	held := pool.GetHeld("ns/VirtualMachine/vm")
+	if held == nil || held.IP != "192.168.1.10" || !held.ExpirationTime.Equal(&expiration) {
+		t.Fatalf("GetHeld() = %+v, want 192.168.1.10 held until %v", held, expiration)
+	}
+	if len(pool.Status.Held) != 1 || len(pool.Status.Allocations) != 0 {
+		t.Errorf("status = %+v, want one held address and no allocations", pool.Status)
+	}
+	if pool.Status.HeldCount != 1 || pool.Status.FreeCount != 9 {
+		t.Errorf("HeldCount = %d, FreeCount = %d, want 1 and 9", pool.Status.HeldCount, pool.Status.FreeCount)
+	}
+	if next, ok := pool.NextHeldExpiration(); !ok || !next.Equal(&expiration) {
+		t.Errorf("NextHeldExpiration() = %v, %v, want %v", next, ok, expiration)
+	}
+
+	// Reclaiming the address removes its hold.
+	pool.SetStickyAllocation("192.168.1.10", NetworkInterfaceReference{Name: "ni", Namespace: "ns", UID: "new-uid"},
+		"ns/VirtualMachine/vm")
+	if pool.GetHeld("ns/VirtualMachine/vm") != nil || pool.Status.HeldCount != 0 {
+		t.Errorf("hold of the reclaimed address was not removed: %+v", pool.Status)
+	}
+}
+
+func TestExpireHeld(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Hour))
+	pool := &IPPool{
+		Spec: IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+		Status: IPPoolStatus{
+			Held: []IPPoolHeldAddress{
+				{IP: "192.168.1.10", Identity: "expired", ExpirationTime: now},
+				{IP: "192.168.1.11", Identity: "held", ExpirationTime: later},
+			},
+		},
+	}
+	if n := pool.ExpireHeld(now); n != 1 {
+		t.Errorf("ExpireHeld() = %d, want 1", n)
+	}
+	if pool.GetHeld("expired") != nil || pool.GetHeld("held") == nil {
+		t.Errorf("Held = %+v, want only the address held until %v", pool.Status.Held, later)
+	}
+	if pool.Status.HeldCount != 1 || pool.Status.FreeCount != 9 {
+		t.Errorf("HeldCount = %d, FreeCount = %d, want 1 and 9", pool.Status.HeldCount, pool.Status.FreeCount)
+	}
+	if _, ok := (&IPPool{}).NextHeldExpiration(); ok {
+		t.Errorf("NextHeldExpiration() of a pool without held addresses returned true")
+	}
+}
+
+func TestStickyAllocationGracePeriod(t *testing.T) {
+	pool := &IPPool{}
+	if d := pool.StickyAllocationGracePeriod(); d != 0 {
+		t.Errorf("StickyAllocationGracePeriod() without sticky allocation = %v, want 0", d)
+	}
+	pool.Spec.StickyAllocation = &StickyAllocation{}
+	if d := pool.StickyAllocationGracePeriod(); d != DefaultStickyAllocationGracePeriod {
+		t.Errorf("StickyAllocationGracePeriod() = %v, want the default %v", d, DefaultStickyAllocationGracePeriod)
+	}
+	pool.Spec.StickyAllocation.GracePeriod = metav1.Duration{Duration: time.Minute}
+	if d := pool.StickyAllocationGracePeriod(); d != time.Minute {
+		t.Errorf("StickyAllocationGracePeriod() = %v, want 1m", d)
+	}
+}
+
+// fullCondition returns the IPPoolFull condition of the pool, or nil.
+func fullCondition(pool *IPPool) *IPPoolCondition {
+	for i := range pool.Status.Conditions {
+		if pool.Status.Conditions[i].Type == IPPoolFull {
+			return &pool.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func TestAllocationCounts(t *testing.T) {
+	pool := &IPPool{Spec: IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 2}}
+	ni := NetworkInterfaceReference{Name: "ni", Namespace: "ns", UID: "uid"}
+	other := NetworkInterfaceReference{Name: "other", Namespace: "ns", UID: "other-uid"}
+
+	pool.SetAllocation("192.168.1.10", ni)
+	if s := pool.Status; s.AddressCount != 2 || s.AllocatedCount != 1 || s.FreeCount != 1 {
+		t.Errorf("AddressCount = %d, AllocatedCount = %d, FreeCount = %d, want 2, 1 and 1",
+			s.AddressCount, s.AllocatedCount, s.FreeCount)
+	}
+	if c := fullCondition(pool); c == nil || c.Status != corev1.ConditionFalse || c.Reason != IPPoolFullReasonAvailable {
+		t.Errorf("IPPoolFull = %+v, want False with reason %s", c, IPPoolFullReasonAvailable)
+	}
+
+	// The pool is full once its last address is allocated.
+	pool.SetAllocation("192.168.1.11", other)
+	if c := fullCondition(pool); c == nil || c.Status != corev1.ConditionTrue || c.Reason != IPPoolFullReasonExhausted {
+		t.Errorf("IPPoolFull = %+v, want True with reason %s", c, IPPoolFullReasonExhausted)
+	}
+	if len(pool.Status.Conditions) != 1 {
+		t.Errorf("Conditions = %+v, want a single IPPoolFull condition", pool.Status.Conditions)
+	}
+
+	// Replacing the owner of an address does not change the counts.
+	pool.SetAllocation("192.168.1.11", ni)
+	if pool.Status.AllocatedCount != 2 || len(pool.GetAllocationsFor(ni)) != 2 {
+		t.Errorf("AllocatedCount = %d, allocations of ns/ni = %+v, want 2 and 2",
+			pool.Status.AllocatedCount, pool.GetAllocationsFor(ni))
+	}
+
+	if n := pool.RemoveAllocationsFor(ni); n != 2 {
+		t.Errorf("RemoveAllocationsFor() = %d, want 2", n)
+	}
+	if n := pool.RemoveAllocationsFor(ni); n != 0 {
+		t.Errorf("RemoveAllocationsFor() without allocations = %d, want 0", n)
+	}
+	if s := pool.Status; s.AllocatedCount != 0 || s.FreeCount != 2 {
+		t.Errorf("AllocatedCount = %d, FreeCount = %d, want 0 and 2", s.AllocatedCount, s.FreeCount)
+	}
+	if c := fullCondition(pool); c == nil || c.Status != corev1.ConditionFalse {
+		t.Errorf("IPPoolFull = %+v after the addresses were removed, want False", c)
+	}
+	if pool.RemoveAllocation("192.168.1.10") {
+		t.Errorf("RemoveAllocation() of a free address = true")
+	}
+}
+
+func TestUpdateAllocationStatusInvalidSpec(t *testing.T) {
+	pool := &IPPool{Spec: IPPoolSpec{StartingAddress: "192.168.1", AddressCount: 2}}
+	pool.UpdateAllocationStatus()
+	c := fullCondition(pool)
+	if c == nil || c.Status != corev1.ConditionUnknown || c.Reason != IPPoolFullReasonInvalidSpec {
+		t.Errorf("IPPoolFull of an invalid pool = %+v, want Unknown with reason %s", c, IPPoolFullReasonInvalidSpec)
+	}
+	if pool.Status.AddressCount != 0 || pool.Status.FreeCount != 0 {
+		t.Errorf("AddressCount = %d, FreeCount = %d, want 0 and 0", pool.Status.AddressCount, pool.Status.FreeCount)
+	}
+}
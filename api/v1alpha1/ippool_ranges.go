@@ -0,0 +1,265 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+)
+
+// AddressRange is a contiguous range of IP addresses of an IPPool, from Start
+// to End inclusive. Both addresses are in their canonical length, 4 bytes for
+// IPv4 and 16 bytes for IPv6.
+// +kubebuilder:object:generate=false
+type AddressRange struct {
+	Start net.IP
+	End   net.IP
+}
+
+// Size returns the number of addresses in the range.
+func (r AddressRange) Size() int64 {
+	size := new(big.Int).Sub(ipaddr.ToInt(r.End), ipaddr.ToInt(r.Start))
+	return size.Int64() + 1
+}
+
+// Family returns the IP family of the addresses in the range.
+func (r AddressRange) Family() corev1.IPFamily {
+	if len(r.Start) == net.IPv4len {
+		return corev1.IPv4Protocol
+	}
+	return corev1.IPv6Protocol
+}
+
+// span is a contiguous range of addresses as unsigned integers.
+type span struct {
+	first, last *big.Int
+}
+
+// AddressRanges returns the addresses of the pool described by the spec as a
+// list of disjoint ranges in ascending order. The addresses of the pool are
+// those of StartingAddress and AddressCount, Ranges and CIDRs, without those
+// of Exclusions. Only the first math.MaxInt64 addresses of larger pools, ex.
+// an IPv6 /64, are returned. An error is returned if the spec is invalid, if
+// its addresses are not all of the same family or if it has no addresses.
+func (s *IPPoolSpec) AddressRanges() ([]AddressRange, error) {
+	var (
+		family   corev1.IPFamily
+		included []span
+		excluded []span
+	)
+	sameFamily := func(f corev1.IPFamily, what string) error {
+		if family == "" {
+			family = f
+		}
+		if f != family {
+			return fmt.Errorf("%s is not an %s address like the other addresses of the pool", what, family)
+		}
+		return nil
+	}
+
+	if s.StartingAddress != "" || s.AddressCount != 0 {
+		sp, f, err := countSpan(s.StartingAddress, s.AddressCount)
+		if err != nil {
+			return nil, err
+		}
+		if err := sameFamily(f, s.StartingAddress); err != nil {
+			return nil, err
+		}
+		included = append(included, sp)
+	}
+	for _, r := range s.Ranges {
+		sp, f, err := r.span()
+		if err != nil {
+			return nil, err
+		}
+		if err := sameFamily(f, r.String()); err != nil {
+			return nil, err
+		}
+		included = append(included, sp)
+	}
+	for _, c := range s.CIDRs {
+		sp, f, err := cidrSpan(c)
+		if err != nil {
+			return nil, err
+		}
+		if err := sameFamily(f, c); err != nil {
+			return nil, err
+		}
+		included = append(included, sp)
+	}
+	if len(included) == 0 {
+		return nil, errors.New("pool has no addresses")
+	}
+	for _, r := range s.Exclusions {
+		sp, f, err := r.span()
+		if err != nil {
+			return nil, err
+		}
+		if err := sameFamily(f, r.String()); err != nil {
+			return nil, err
+		}
+		excluded = append(excluded, sp)
+	}
+
+	spans := subtractSpans(mergeSpans(included), mergeSpans(excluded))
+	if len(spans) == 0 {
+		return nil, errors.New("pool has no addresses that are not excluded")
+	}
+
+	remaining := big.NewInt(math.MaxInt64)
+	ranges := make([]AddressRange, 0, len(spans))
+	for _, sp := range spans {
+		size := new(big.Int).Sub(sp.last, sp.first)
+		size.Add(size, big.NewInt(1))
+		if size.Cmp(remaining) > 0 {
+			size.Set(remaining)
+			sp.last = new(big.Int).Add(sp.first, size)
+			sp.last.Sub(sp.last, big.NewInt(1))
+		}
+		ranges = append(ranges, AddressRange{Start: ipaddr.FromInt(sp.first, family), End: ipaddr.FromInt(sp.last, family)})
+		if remaining.Sub(remaining, size).Sign() == 0 {
+			break
+		}
+	}
+	return ranges, nil
+}
+
+// Size returns the number of addresses in the pool described by the spec, or
+// 0 if the spec is invalid.
+func (s *IPPoolSpec) Size() int64 {
+	ranges, err := s.AddressRanges()
+	if err != nil {
+		return 0
+	}
+	var size int64
+	for _, r := range ranges {
+		size += r.Size()
+	}
+	return size
+}
+
+// String returns the range as start-end, or start if the range has no end.
+func (r IPRange) String() string {
+	if r.End == "" {
+		return r.Start
+	}
+	return r.Start + "-" + r.End
+}
+
+// span returns the addresses of the range together with their family.
+func (r IPRange) span() (span, corev1.IPFamily, error) {
+	start, family, ok := ipaddr.Parse(r.Start)
+	if !ok {
+		return span{}, "", fmt.Errorf("range start %q is not a valid IP address", r.Start)
+	}
+	first := ipaddr.ToInt(start)
+	if r.End == "" {
+		return span{first: first, last: first}, family, nil
+	}
+	end, endFamily, ok := ipaddr.Parse(r.End)
+	if !ok {
+		return span{}, "", fmt.Errorf("range end %q is not a valid IP address", r.End)
+	}
+	if endFamily != family {
+		return span{}, "", fmt.Errorf("range %s mixes %s and %s addresses", r, family, endFamily)
+	}
+	last := ipaddr.ToInt(end)
+	if last.Cmp(first) < 0 {
+		return span{}, "", fmt.Errorf("range %s ends before it starts", r)
+	}
+	return span{first: first, last: last}, family, nil
+}
+
+// countSpan returns the count addresses starting at start together with their
+// family.
+func countSpan(start string, count int64) (span, corev1.IPFamily, error) {
+	ip, family, ok := ipaddr.Parse(start)
+	if !ok {
+		return span{}, "", fmt.Errorf("startingAddress %q is not a valid IP address", start)
+	}
+	if count <= 0 {
+		return span{}, "", errors.New("addressCount must be greater than zero")
+	}
+	first := ipaddr.ToInt(ip)
+	last := new(big.Int).Add(first, big.NewInt(count-1))
+	if last.BitLen() > len(ip)*8 {
+		return span{}, "", fmt.Errorf("%d addresses starting at %s exceed the %s address space", count, start, family)
+	}
+	return span{first: first, last: last}, family, nil
+}
+
+// cidrSpan returns the addresses of the given CIDR together with their family.
+// The network and broadcast addresses of IPv4 prefixes shorter than /31 are
+// not included.
+func cidrSpan(cidr string) (span, corev1.IPFamily, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return span{}, "", fmt.Errorf("%q is not a valid CIDR", cidr)
+	}
+	ip, family, _ := ipaddr.Canonical(ipNet.IP)
+	ones, bits := ipNet.Mask.Size()
+
+	first := ipaddr.ToInt(ip)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last := new(big.Int).Add(first, size)
+	last.Sub(last, big.NewInt(1))
+	if family == corev1.IPv4Protocol && ones < 31 {
+		first.Add(first, big.NewInt(1))
+		last.Sub(last, big.NewInt(1))
+	}
+	return span{first: first, last: last}, family, nil
+}
+
+// mergeSpans returns the given spans sorted in ascending order, with the
+// spans that overlap or are adjacent merged.
+func mergeSpans(spans []span) []span {
+	sort.Slice(spans, func(i, j int) bool { return spans[i].first.Cmp(spans[j].first) < 0 })
+	var merged []span
+	for _, sp := range spans {
+		if n := len(merged); n > 0 {
+			next := new(big.Int).Add(merged[n-1].last, big.NewInt(1))
+			if sp.first.Cmp(next) <= 0 {
+				if sp.last.Cmp(merged[n-1].last) > 0 {
+					merged[n-1].last = sp.last
+				}
+				continue
+			}
+		}
+		merged = append(merged, span{first: sp.first, last: sp.last})
+	}
+	return merged
+}
+
+// subtractSpans returns the addresses of spans that are not in excluded. Both
+// lists must be sorted and merged, see mergeSpans.
+func subtractSpans(spans, excluded []span) []span {
+	var result []span
+	for _, sp := range spans {
+		first := sp.first
+		for _, ex := range excluded {
+			if ex.last.Cmp(first) < 0 {
+				continue
+			}
+			if ex.first.Cmp(sp.last) > 0 {
+				break
+			}
+			if ex.first.Cmp(first) > 0 {
+				result = append(result, span{first: first, last: new(big.Int).Sub(ex.first, big.NewInt(1))})
+			}
+			first = new(big.Int).Add(ex.last, big.NewInt(1))
+		}
+		if first.Cmp(sp.last) <= 0 {
+			result = append(result, span{first: first, last: sp.last})
+		}
+	}
+	return result
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAddressRanges(t *testing.T) {
+	tests := []struct {
+		name string
+		spec IPPoolSpec
+		// want are the ranges as start-end, or nil if the spec is invalid.
+		want []string
+		size int64
+	}{
+		{
+			name: "legacy",
+			spec: IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+			want: []string{"192.168.1.10-192.168.1.19"},
+			size: 10,
+		},
+		{
+			name: "legacy with ranges",
+			spec: IPPoolSpec{
+				StartingAddress: "192.168.1.10",
+				AddressCount:    10,
+				Ranges:          []IPRange{{Start: "192.168.1.100", End: "192.168.1.109"}, {Start: "192.168.1.5"}},
+			},
+			want: []string{"192.168.1.5-192.168.1.5", "192.168.1.10-192.168.1.19", "192.168.1.100-192.168.1.109"},
+			size: 21,
+		},
+		{
+			name: "overlapping ranges",
+			spec: IPPoolSpec{
+				StartingAddress: "192.168.1.10",
+				AddressCount:    10,
+				Ranges:          []IPRange{{Start: "192.168.1.15", End: "192.168.1.25"}, {Start: "192.168.1.12", End: "192.168.1.13"}},
+			},
+			want: []string{"192.168.1.10-192.168.1.25"},
+			size: 16,
+		},
+		{
+			name: "adjacent ranges",
+			spec: IPPoolSpec{
+				Ranges: []IPRange{{Start: "192.168.1.20", End: "192.168.1.29"}, {Start: "192.168.1.10", End: "192.168.1.19"}},
+			},
+			want: []string{"192.168.1.10-192.168.1.29"},
+			size: 20,
+		},
+		{
+			name: "ranges with a gap",
+			spec: IPPoolSpec{
+				Ranges: []IPRange{{Start: "192.168.1.10", End: "192.168.1.19"}, {Start: "192.168.1.21", End: "192.168.1.29"}},
+			},
+			want: []string{"192.168.1.10-192.168.1.19", "192.168.1.21-192.168.1.29"},
+			size: 19,
+		},
+		{
+			name: "exclusion splitting a range",
+			spec: IPPoolSpec{
+				Ranges:     []IPRange{{Start: "192.168.1.10", End: "192.168.1.29"}},
+				Exclusions: []IPRange{{Start: "192.168.1.15", End: "192.168.1.19"}, {Start: "192.168.1.25"}},
+			},
+			want: []string{"192.168.1.10-192.168.1.14", "192.168.1.20-192.168.1.24", "192.168.1.26-192.168.1.29"},
+			size: 14,
+		},
+		{
+			name: "exclusions trimming a range",
+			spec: IPPoolSpec{
+				Ranges:     []IPRange{{Start: "192.168.1.10", End: "192.168.1.29"}},
+				Exclusions: []IPRange{{Start: "192.168.1.0", End: "192.168.1.11"}, {Start: "192.168.1.28", End: "192.168.1.40"}},
+			},
+			want: []string{"192.168.1.12-192.168.1.27"},
+			size: 16,
+		},
+		{
+			name: "exclusion removing a range",
+			spec: IPPoolSpec{
+				Ranges:     []IPRange{{Start: "192.168.1.10", End: "192.168.1.19"}, {Start: "192.168.1.30", End: "192.168.1.39"}},
+				Exclusions: []IPRange{{Start: "192.168.1.10", End: "192.168.1.19"}},
+			},
+			want: []string{"192.168.1.30-192.168.1.39"},
+			size: 10,
+		},
+		{
+			name: "exclusion across two ranges",
+			spec: IPPoolSpec{
+				Ranges:     []IPRange{{Start: "192.168.1.10", End: "192.168.1.19"}, {Start: "192.168.1.30", End: "192.168.1.39"}},
+				Exclusions: []IPRange{{Start: "192.168.1.15", End: "192.168.1.34"}},
+			},
+			want: []string{"192.168.1.10-192.168.1.14", "192.168.1.35-192.168.1.39"},
+			size: 10,
+		},
+		{
+			name: "everything excluded",
+			spec: IPPoolSpec{
+				Ranges:     []IPRange{{Start: "192.168.1.10", End: "192.168.1.19"}},
+				Exclusions: []IPRange{{Start: "192.168.1.0", End: "192.168.1.255"}},
+			},
+		},
+		{
+			name: "/30",
+			spec: IPPoolSpec{CIDRs: []string{"192.168.1.0/30"}},
+			want: []string{"192.168.1.1-192.168.1.2"},
+			size: 2,
+		},
+		{
+			name: "/31",
+			spec: IPPoolSpec{CIDRs: []string{"192.168.1.0/31"}},
+			want: []string{"192.168.1.0-192.168.1.1"},
+			size: 2,
+		},
+		{
+			name: "/32",
+			spec: IPPoolSpec{CIDRs: []string{"192.168.1.7/32"}},
+			want: []string{"192.168.1.7-192.168.1.7"},
+			size: 1,
+		},
+		{
+			name: "CIDR with host bits",
+			spec: IPPoolSpec{CIDRs: []string{"192.168.1.77/24"}},
+			want: []string{"192.168.1.1-192.168.1.254"},
+			size: 254,
+		},
+		{
+			name: "CIDRs and exclusions",
+			spec: IPPoolSpec{
+				CIDRs:      []string{"192.168.1.0/25", "192.168.1.128/25"},
+				Exclusions: []IPRange{{Start: "192.168.1.1", End: "192.168.1.9"}},
+			},
+			// The network and broadcast addresses of each CIDR are removed,
+			// including those in the middle of the merged range.
+			want: []string{"192.168.1.10-192.168.1.126", "192.168.1.129-192.168.1.254"},
+			size: 243,
+		},
+		{
+			name: "IPv6",
+			spec: IPPoolSpec{
+				CIDRs:      []string{"fd00::/120"},
+				Exclusions: []IPRange{{Start: "fd00::", End: "fd00::f"}},
+			},
+			// IPv6 prefixes have no network or broadcast address.
+			want: []string{"fd00::10-fd00::ff"},
+			size: 240,
+		},
+		{
+			name: "IPv6 capped at MaxInt64",
+			spec: IPPoolSpec{CIDRs: []string{"fd00::/64"}},
+			want: []string{"fd00::-fd00::7fff:ffff:ffff:fffe"},
+			size: math.MaxInt64,
+		},
+		{
+			name: "IPv6 capped at MaxInt64 across ranges",
+			spec: IPPoolSpec{
+				Ranges: []IPRange{{Start: "fd00::1", End: "fd00::10"}},
+				CIDRs:  []string{"fd01::/64"},
+			},
+			want: []string{"fd00::1-fd00::10", "fd01::-fd01::7fff:ffff:ffff:ffee"},
+			size: math.MaxInt64,
+		},
+		{
+			name: "mixed families",
+			spec: IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10, CIDRs: []string{"fd00::/120"}},
+		},
+		{
+			name: "exclusion of the other family",
+			spec: IPPoolSpec{CIDRs: []string{"fd00::/120"}, Exclusions: []IPRange{{Start: "192.168.1.1"}}},
+		},
+		{
+			name: "range mixing families",
+			spec: IPPoolSpec{Ranges: []IPRange{{Start: "192.168.1.1", End: "fd00::1"}}},
+		},
+		{
+			name: "reversed range",
+			spec: IPPoolSpec{Ranges: []IPRange{{Start: "192.168.1.10", End: "192.168.1.1"}}},
+		},
+		{
+			name: "invalid CIDR",
+			spec: IPPoolSpec{CIDRs: []string{"192.168.1.0/33"}},
+		},
+		{
+			name: "no addresses",
+			spec: IPPoolSpec{},
+		},
+	}
+	for _, tt := range tests {
+		ranges, err := tt.spec.AddressRanges()
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("%s: AddressRanges() = %v, want an error", tt.name, ranges)
+			}
+			if size := tt.spec.Size(); size != 0 {
+				t.Errorf("%s: Size() = %d, want 0", tt.name, size)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: AddressRanges() = %v", tt.name, err)
+			continue
+		}
+		got := make([]string, 0, len(ranges))
+		for _, r := range ranges {
+			got = append(got, r.Start.String()+"-"+r.End.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: AddressRanges() = %v, want %v", tt.name, got, tt.want)
+		}
+		if size := tt.spec.Size(); size != tt.size {
+			t.Errorf("%s: Size() = %d, want %d", tt.name, size, tt.size)
+		}
+	}
+}
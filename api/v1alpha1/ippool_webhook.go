@@ -0,0 +1,78 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-ippool,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=ippools,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mippool.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-ippool,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=ippools,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vippool.netoperator.vmware.com
+
+// Default sets the default values of an IPPool. The grace period of sticky
+// allocation defaults to DefaultStickyAllocationGracePeriod.
+func (p *IPPool) Default() {
+	if sa := p.Spec.StickyAllocation; sa != nil && sa.GracePeriod.Duration == 0 {
+		sa.GracePeriod = metav1.Duration{Duration: DefaultStickyAllocationGracePeriod}
+	}
+}
+
+// ValidateCreate validates an IPPool on creation.
+func (p *IPPool) ValidateCreate() error {
+	return invalid("IPPool", p.Name, p.validate())
+}
+
+// ValidateUpdate validates an IPPool on update.
+func (p *IPPool) ValidateUpdate(old runtime.Object) error {
+	return invalid("IPPool", p.Name, p.validate())
+}
+
+// ValidateDelete validates an IPPool on deletion.
+func (p *IPPool) ValidateDelete() error {
+	return nil
+}
+
+func (p *IPPool) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	if p.Spec.StartingAddress != "" || p.Spec.AddressCount != 0 {
+		allErrs = append(allErrs, validateAddressRange(p.Spec.StartingAddress, p.Spec.AddressCount,
+			specPath.Child("startingAddress"), specPath.Child("addressCount"))...)
+	} else if len(p.Spec.Ranges) == 0 && len(p.Spec.CIDRs) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("ranges"),
+			"at least one of startingAddress, ranges or cidrs must be set"))
+	}
+	for i, r := range p.Spec.Ranges {
+		allErrs = append(allErrs, validateIPRange(r, specPath.Child("ranges").Index(i))...)
+	}
+	for i, c := range p.Spec.CIDRs {
+		allErrs = append(allErrs, validateCIDR(c, specPath.Child("cidrs").Index(i))...)
+	}
+	for i, r := range p.Spec.Exclusions {
+		allErrs = append(allErrs, validateIPRange(r, specPath.Child("exclusions").Index(i))...)
+	}
+	// The addresses must all be of the same family, and not all be excluded.
+	if len(allErrs) == 0 {
+		if _, err := p.Spec.AddressRanges(); err != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath, err.Error()))
+		}
+	}
+
+	if sa := p.Spec.StickyAllocation; sa != nil {
+		saPath := specPath.Child("stickyAllocation")
+		if sa.IdentityLabel != "" {
+			for _, msg := range validation.IsQualifiedName(sa.IdentityLabel) {
+				allErrs = append(allErrs, field.Invalid(saPath.Child("identityLabel"), sa.IdentityLabel, msg))
+			}
+		}
+		if sa.GracePeriod.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(saPath.Child("gracePeriod"), sa.GracePeriod.Duration.String(),
+				"must not be negative"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestIPPoolValidateStartingAddress(t *testing.T) {
+	tests := []struct {
+		start string
+		count int64
+		valid bool
+	}{
+		{"192.168.1.10", 100, true},
+		{"255.255.255.0", 256, true},
+		{"fd00::1", 1 << 40, true},
+		{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00", 256, true},
+		{"255.255.255.0", 257, false},
+		{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00", 257, false},
+		{"192.168.1.10", 0, false},
+		{"192.168.1.10", -1, false},
+		{"192.168.1", 100, false},
+		{"", 100, false},
+	}
+	for _, tt := range tests {
+		p := &IPPool{Spec: IPPoolSpec{StartingAddress: tt.start, AddressCount: tt.count}}
+		err := p.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("ValidateCreate() with startingAddress %q and addressCount %d = %v, want valid %v",
+				tt.start, tt.count, err, tt.valid)
+		}
+	}
+}
+
+func TestIPPoolValidateRanges(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  IPPoolSpec
+		valid bool
+	}{
+		{"ranges", IPPoolSpec{Ranges: []IPRange{{Start: "192.168.1.10", End: "192.168.1.19"}, {Start: "192.168.1.30"}}}, true},
+		{"CIDRs", IPPoolSpec{CIDRs: []string{"192.168.1.0/24", "192.168.2.0/31"}}, true},
+		{"IPv6 CIDR", IPPoolSpec{CIDRs: []string{"fd00::/64"}}, true},
+		{"legacy with ranges and exclusions", IPPoolSpec{
+			StartingAddress: "192.168.1.10",
+			AddressCount:    10,
+			Ranges:          []IPRange{{Start: "192.168.1.100", End: "192.168.1.109"}},
+			Exclusions:      []IPRange{{Start: "192.168.1.15"}},
+		}, true},
+		{"no addresses", IPPoolSpec{}, false},
+		{"invalid range start", IPPoolSpec{Ranges: []IPRange{{Start: "192.168.1", End: "192.168.1.19"}}}, false},
+		{"invalid range end", IPPoolSpec{Ranges: []IPRange{{Start: "192.168.1.10", End: "192.168.1.256"}}}, false},
+		{"missing range start", IPPoolSpec{Ranges: []IPRange{{End: "192.168.1.19"}}}, false},
+		{"reversed range", IPPoolSpec{Ranges: []IPRange{{Start: "192.168.1.19", End: "192.168.1.10"}}}, false},
+		{"range mixing families", IPPoolSpec{Ranges: []IPRange{{Start: "192.168.1.10", End: "fd00::10"}}}, false},
+		{"CIDR prefix too long", IPPoolSpec{CIDRs: []string{"192.168.1.0/33"}}, false},
+		{"CIDR without prefix", IPPoolSpec{CIDRs: []string{"192.168.1.0"}}, false},
+		{"invalid CIDR address", IPPoolSpec{CIDRs: []string{"192.168.1/24"}}, false},
+		{"invalid exclusion", IPPoolSpec{
+			CIDRs:      []string{"192.168.1.0/24"},
+			Exclusions: []IPRange{{Start: "192.168.1.20", End: "192.168.1.10"}},
+		}, false},
+		{"mixed families", IPPoolSpec{
+			CIDRs:  []string{"192.168.1.0/24"},
+			Ranges: []IPRange{{Start: "fd00::10", End: "fd00::20"}},
+		}, false},
+		{"exclusion of the other family", IPPoolSpec{
+			CIDRs:      []string{"192.168.1.0/24"},
+			Exclusions: []IPRange{{Start: "fd00::10"}},
+		}, false},
+		{"everything excluded", IPPoolSpec{
+			CIDRs:      []string{"192.168.1.0/30"},
+			Exclusions: []IPRange{{Start: "192.168.1.0", End: "192.168.1.3"}},
+		}, false},
+	}
+	for _, tt := range tests {
+		p := &IPPool{Spec: tt.spec}
+		err := p.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%s: ValidateCreate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
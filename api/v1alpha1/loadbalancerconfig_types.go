@@ -8,6 +8,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ClientSecretDefaultNamespace is the default value of ClientSecretReference.Namespace.
+const ClientSecretDefaultNamespace = "default"
+
 // ClientSecretReference contains info to locate an object of Kind Secret
 // which contains credential specifications for a load balancer.
 type ClientSecretReference struct {
@@ -90,7 +93,13 @@ type LoadBalancerConfigStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=lbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.providerRef.kind"
+// +kubebuilder:printcolumn:name="Provider Name",type="string",JSONPath=".spec.providerRef.name",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // LoadBalancerConfig is the Schema for the LoadBalancerConfigs API
 type LoadBalancerConfig struct {
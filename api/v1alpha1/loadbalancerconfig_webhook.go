@@ -0,0 +1,56 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-loadbalancerconfig,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=loadbalancerconfigs,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mloadbalancerconfig.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-loadbalancerconfig,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=loadbalancerconfigs,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vloadbalancerconfig.netoperator.vmware.com
+
+// Default sets the default values of a LoadBalancerConfig. LoadBalancerConfig has no fields with
+// default values.
+func (c *LoadBalancerConfig) Default() {
+}
+
+// ValidateCreate validates a LoadBalancerConfig on creation.
+func (c *LoadBalancerConfig) ValidateCreate() error {
+	return invalid("LoadBalancerConfig", c.Name, c.validate())
+}
+
+// ValidateUpdate validates a LoadBalancerConfig on update.
+func (c *LoadBalancerConfig) ValidateUpdate(old runtime.Object) error {
+	return invalid("LoadBalancerConfig", c.Name, c.validate())
+}
+
+// ValidateDelete validates a LoadBalancerConfig on deletion.
+func (c *LoadBalancerConfig) ValidateDelete() error {
+	return nil
+}
+
+func (c *LoadBalancerConfig) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	switch c.Spec.Type {
+	case LoadBalancerConfigTypeHAProxy, LoadBalancerConfigTypeAvi:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("type"), c.Spec.Type,
+			[]string{string(LoadBalancerConfigTypeHAProxy), string(LoadBalancerConfigTypeAvi)}))
+	}
+
+	refPath := specPath.Child("providerRef")
+	allErrs = append(allErrs, validateRequired(c.Spec.ProviderRef.Kind, refPath.Child("kind"))...)
+	allErrs = append(allErrs, validateRequired(c.Spec.ProviderRef.Name, refPath.Child("name"))...)
+	return allErrs
+}
+
+// Default sets the default values of a ClientSecretReference.
+func (r *ClientSecretReference) Default() {
+	if r.Namespace == "" {
+		r.Namespace = ClientSecretDefaultNamespace
+	}
+}
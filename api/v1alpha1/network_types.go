@@ -52,6 +52,12 @@ type NetworkStatus struct {
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=net,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.providerRef.kind"
+// +kubebuilder:printcolumn:name="Provider Name",type="string",JSONPath=".spec.providerRef.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Network is the Schema for the networks API.
 // A Network describes type, class and common attributes of a network available
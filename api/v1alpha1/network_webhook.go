@@ -0,0 +1,53 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-network,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=networks,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mnetwork.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-network,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=networks,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vnetwork.netoperator.vmware.com
+
+// Default sets the default values of a Network. Network has no fields with
+// default values.
+func (n *Network) Default() {
+}
+
+// ValidateCreate validates a Network on creation.
+func (n *Network) ValidateCreate() error {
+	return invalid("Network", n.Name, n.validate())
+}
+
+// ValidateUpdate validates a Network on update.
+func (n *Network) ValidateUpdate(old runtime.Object) error {
+	return invalid("Network", n.Name, n.validate())
+}
+
+// ValidateDelete validates a Network on deletion.
+func (n *Network) ValidateDelete() error {
+	return nil
+}
+
+func (n *Network) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	switch n.Spec.Type {
+	case NetworkTypeNSXT, NetworkTypeVDS:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("type"), n.Spec.Type,
+			[]string{string(NetworkTypeNSXT), string(NetworkTypeVDS)}))
+	}
+
+	refPath := specPath.Child("providerRef")
+	allErrs = append(allErrs, validateRequired(n.Spec.ProviderRef.Kind, refPath.Child("kind"))...)
+	allErrs = append(allErrs, validateRequired(n.Spec.ProviderRef.Name, refPath.Child("name"))...)
+
+	for i, dns := range n.Spec.DNS {
+		allErrs = append(allErrs, validateIP(dns, "", specPath.Child("dns").Index(i))...)
+	}
+	return allErrs
+}
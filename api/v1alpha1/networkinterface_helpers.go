@@ -0,0 +1,51 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Family returns the IP family of the destination of the route, or an empty
+// family if the destination is not a valid CIDR.
+func (r Route) Family() corev1.IPFamily {
+	ip, _, err := net.ParseCIDR(r.Destination)
+	if err != nil {
+		return ""
+	}
+	if ip.To4() != nil {
+		return corev1.IPv4Protocol
+	}
+	return corev1.IPv6Protocol
+}
+
+// MergeRoutes adds the given routes of the family of the IPConfig to its
+// routes, except those whose destination already is the destination of one
+// of its routes, so that the routes of the IPConfig take precedence over
+// those of its network.
+func (c *IPConfig) MergeRoutes(routes []Route) {
+	for _, r := range routes {
+		if r.Family() != c.IPFamily || c.hasRouteTo(r.Destination) {
+			continue
+		}
+		c.Routes = append(c.Routes, r)
+	}
+}
+
+// hasRouteTo returns true if the IPConfig has a route to the given
+// destination.
+func (c *IPConfig) hasRouteTo(destination string) bool {
+	_, dest, err := net.ParseCIDR(destination)
+	if err != nil {
+		return false
+	}
+	for _, r := range c.Routes {
+		if _, d, err := net.ParseCIDR(r.Destination); err == nil && d.String() == dest.String() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRouteFamily(t *testing.T) {
+	tests := []struct {
+		destination string
+		want        corev1.IPFamily
+	}{
+		{"10.20.0.0/16", corev1.IPv4Protocol},
+		{"0.0.0.0/0", corev1.IPv4Protocol},
+		{"fd00:20::/64", corev1.IPv6Protocol},
+		{"::/0", corev1.IPv6Protocol},
+		{"10.20.0.0", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := (Route{Destination: tt.destination}).Family(); got != tt.want {
+			t.Errorf("Family() of route to %q = %q, want %q", tt.destination, got, tt.want)
+		}
+	}
+}
+
+func TestMergeRoutes(t *testing.T) {
+	c := IPConfig{
+		IP:       "10.10.1.10",
+		IPFamily: corev1.IPv4Protocol,
+		Routes:   []Route{{Destination: "10.20.0.0/16", NextHop: "10.10.1.2", Metric: 10}},
+	}
+	c.MergeRoutes([]Route{
+		// The route of the IPConfig to the same prefix takes precedence.
+		{Destination: "10.20.1.0/16", NextHop: "10.10.1.1"},
+		{Destination: "10.30.0.0/16", NextHop: "10.10.1.1", Metric: 100},
+		// Routes of the other family are not merged.
+		{Destination: "fd00:30::/64", NextHop: "fd00::1"},
+	})
+	want := []Route{
+		{Destination: "10.20.0.0/16", NextHop: "10.10.1.2", Metric: 10},
+		{Destination: "10.30.0.0/16", NextHop: "10.10.1.1", Metric: 100},
+	}
+	if !reflect.DeepEqual(c.Routes, want) {
+		t.Errorf("Routes = %+v, want %+v", c.Routes, want)
+	}
+}
@@ -29,6 +29,29 @@ type IPConfig struct {
 	Gateway string `json:"gateway"`
 	// SubnetMask setting.
 	SubnetMask string `json:"subnetMask"`
+	// Routes is the list of static routes of the IP family of IP, in addition to the default
+	// route through Gateway. A network interface without Gateway and with Routes only routes
+	// traffic to the destinations of its routes.
+	// +optional
+	Routes []Route `json:"routes,omitempty"`
+	// Nameservers is the list of IP addresses of the DNS servers of the network interface, used
+	// before the DNS servers of its network.
+	// +optional
+	Nameservers []string `json:"nameservers,omitempty"`
+}
+
+// Route is a static route.
+type Route struct {
+	// Destination is the destination prefix of the route in CIDR notation, ex. 10.10.0.0/16.
+	Destination string `json:"destination"`
+	// NextHop is the IP address of the router of the route. It must be of the family of
+	// Destination. If unset, the destination is directly reachable on the link.
+	// +optional
+	NextHop string `json:"nextHop,omitempty"`
+	// Metric is the metric of the route. Routes with a lower metric are preferred.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Metric int32 `json:"metric,omitempty"`
 }
 
 // NetworkInterfaceProviderReference contains info to locate a network interface provider object.
@@ -43,6 +66,16 @@ type NetworkInterfaceProviderReference struct {
 	APIVersion string `json:"apiVersion,omitempty"`
 }
 
+// RequestedIPConfig is a request for a specific IP address.
+type RequestedIPConfig struct {
+	// IP is the requested IP address. It must be within the IPPools of the network.
+	IP string `json:"ip"`
+	// IPFamily specifies the IP family (IPv4 vs IPv6) the IP belongs to. Defaults to the family
+	// of IP.
+	// +optional
+	IPFamily corev1.IPFamily `json:"ipFamily,omitempty"`
+}
+
 type NetworkInterfaceConditionType string
 
 const (
@@ -62,6 +95,10 @@ const (
 	// NetworkInterfaceFailureReasonCannotAllocPort indicates NetworkInterface is in failed state because
 	// port cannot be allocated for network interface on the network.
 	NetworkInterfaceFailureReasonCannotAllocPort NetworkInterfaceConditionReason = "CannotAllocPort"
+	// NetworkInterfaceFailureReasonRequestedIPUnavailable indicates NetworkInterface is in failed state
+	// because a requested IP address is not within the IPPools of the network or is allocated to
+	// another network interface.
+	NetworkInterfaceFailureReasonRequestedIPUnavailable NetworkInterfaceConditionReason = "RequestedIPUnavailable"
 )
 
 // NetworkInterfaceCondition describes the state of a NetworkInterface at a certain point.
@@ -110,6 +147,10 @@ type NetworkInterfaceType string
 const (
 	// NetworkInterfaceTypeVMXNet3 is for a VMXNET3 device.
 	NetworkInterfaceTypeVMXNet3 = NetworkInterfaceType("vmxnet3")
+	// NetworkInterfaceTypeE1000E is for an E1000E device.
+	NetworkInterfaceTypeE1000E = NetworkInterfaceType("e1000e")
+	// NetworkInterfaceTypeSRIOV is for an SR-IOV passthrough device.
+	NetworkInterfaceTypeSRIOV = NetworkInterfaceType("sriov")
 )
 
 // NetworkInterfacePortAllocation describes the settings for network interface port allocation request.
@@ -122,7 +163,9 @@ type NetworkInterfacePortAllocation struct {
 type NetworkInterfaceSpec struct {
 	// NetworkName refers to a NetworkObject in the same namespace.
 	NetworkName string `json:"networkName,omitempty"`
-	// Type is the type of NetworkInterface. Supported values are vmxnet3.
+	// Type is the type of NetworkInterface. Supported values are vmxnet3, e1000e and sriov.
+	// Defaults to vmxnet3.
+	// +kubebuilder:default:=vmxnet3
 	Type NetworkInterfaceType `json:"type,omitempty"`
 	// ProviderRef is a reference to a provider specific network interface object
 	// that specifies the network interface configuration.
@@ -134,10 +177,30 @@ type NetworkInterfaceSpec struct {
 	// of attaching a network interface to a network and should be left unset. This is used primarily when
 	// attachment of network interface to the network is done without vCenter Server's knowledge.
 	PortAllocation *NetworkInterfacePortAllocation `json:"portAllocation,omitempty"`
+	// RequestedIPConfigs are the IP addresses requested for this network interface, at most one per
+	// IP family. The addresses are allocated from the IPPools of the network instead of free ones,
+	// which keeps the address of a network interface stable when it is recreated. If unset, any free
+	// address is allocated.
+	// +optional
+	RequestedIPConfigs []RequestedIPConfig `json:"requestedIPConfigs,omitempty"`
+	// DefaultRoute is false to not route traffic through the gateway of the network. The IPConfigs
+	// of the network interface then have no Gateway and only route traffic to the destinations of
+	// their Routes, which keeps the default route of a VM with several network interfaces on one of
+	// them. Defaults to true.
+	// +optional
+	DefaultRoute *bool `json:"defaultRoute,omitempty"`
 }
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=netif,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network",type="string",JSONPath=".spec.networkName"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",priority=1
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.ipConfigs[0].ip"
+// +kubebuilder:printcolumn:name="MAC",type="string",JSONPath=".status.macAddress"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NetworkInterface is the Schema for the networkinterfaces API.
 // A NetworkInterface represents a user's request for network configuration to use to place a
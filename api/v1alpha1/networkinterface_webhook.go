@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-networkinterface,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=networkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mnetworkinterface.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-networkinterface,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=networkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vnetworkinterface.netoperator.vmware.com
+
+// Default sets the default values of a NetworkInterface. The family of a
+// requested IP address defaults to the family of the address.
+func (ni *NetworkInterface) Default() {
+	if ni.Spec.Type == "" {
+		ni.Spec.Type = NetworkInterfaceTypeVMXNet3
+	}
+	for i := range ni.Spec.RequestedIPConfigs {
+		c := &ni.Spec.RequestedIPConfigs[i]
+		if _, family, ok := ipaddr.Parse(c.IP); ok && c.IPFamily == "" {
+			c.IPFamily = family
+		}
+	}
+}
+
+// ValidateCreate validates a NetworkInterface on creation.
+func (ni *NetworkInterface) ValidateCreate() error {
+	return invalid("NetworkInterface", ni.Name, ni.validate())
+}
+
+// ValidateUpdate validates a NetworkInterface on update.
+func (ni *NetworkInterface) ValidateUpdate(old runtime.Object) error {
+	return invalid("NetworkInterface", ni.Name, ni.validate())
+}
+
+// ValidateDelete validates a NetworkInterface on deletion.
+func (ni *NetworkInterface) ValidateDelete() error {
+	return nil
+}
+
+// networkInterfaceProviderKinds maps every NetworkInterfaceType to the kind
+// of its provider.
+var networkInterfaceProviderKinds = map[NetworkInterfaceType]string{
+	NetworkInterfaceTypeVMXNet3: "VMXNET3NetworkInterface",
+	NetworkInterfaceTypeE1000E:  "E1000ENetworkInterface",
+	NetworkInterfaceTypeSRIOV:   "SRIOVNetworkInterface",
+}
+
+func (ni *NetworkInterface) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	niType := ni.Spec.Type
+	if niType == "" {
+		niType = NetworkInterfaceTypeVMXNet3
+	}
+	providerKind, ok := networkInterfaceProviderKinds[niType]
+	if !ok {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("type"), ni.Spec.Type, []string{
+			string(NetworkInterfaceTypeVMXNet3),
+			string(NetworkInterfaceTypeE1000E),
+			string(NetworkInterfaceTypeSRIOV),
+		}))
+	}
+
+	if ref := ni.Spec.ProviderRef; ref != nil {
+		refPath := specPath.Child("providerRef")
+		allErrs = append(allErrs, validateRequired(ref.Kind, refPath.Child("kind"))...)
+		allErrs = append(allErrs, validateRequired(ref.Name, refPath.Child("name"))...)
+		if ok && ref.APIGroup == GroupName && ref.Kind != "" && ref.Kind != providerKind {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("kind"), ref.Kind,
+				"must be "+providerKind+" for a network interface of type "+string(niType)))
+		}
+	}
+
+	if pa := ni.Spec.PortAllocation; pa != nil {
+		allErrs = append(allErrs, validateRequired(pa.NodeName, specPath.Child("portAllocation", "nodeName"))...)
+	}
+
+	allErrs = append(allErrs, validateRequestedIPConfigs(ni.Spec.RequestedIPConfigs, specPath.Child("requestedIPConfigs"))...)
+	return allErrs
+}
+
+// validateRequestedIPConfigs validates that the requested IP addresses are
+// valid addresses of their family, and that at most one address is requested
+// per family. Whether the addresses are within the IPPools of the network and
+// free is checked by the RequestedIPValidator of pkg/webhook, and again when
+// they are allocated.
+func validateRequestedIPConfigs(configs []RequestedIPConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	families := map[corev1.IPFamily]bool{}
+	for i, c := range configs {
+		idxPath := fldPath.Index(i)
+		family := c.IPFamily
+		if family != "" {
+			if errs := validateIPFamily(family, idxPath.Child("ipFamily")); len(errs) > 0 {
+				allErrs = append(allErrs, errs...)
+				family = ""
+			}
+		}
+		if errs := validateIP(c.IP, family, idxPath.Child("ip")); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		_, family, _ = ipaddr.Parse(c.IP)
+		if families[family] {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ip"), c.IP,
+				"at most one "+string(family)+" address may be requested"))
+		}
+		families[family] = true
+	}
+	return allErrs
+}
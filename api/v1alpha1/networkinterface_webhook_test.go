@@ -0,0 +1,45 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import "testing"
+
+func TestNetworkInterfaceValidateProviderKind(t *testing.T) {
+	tests := []struct {
+		name   string
+		niType NetworkInterfaceType
+		group  string
+		kind   string
+		valid  bool
+	}{
+		{"vmxnet3", NetworkInterfaceTypeVMXNet3, GroupName, "VMXNET3NetworkInterface", true},
+		{"default type", "", GroupName, "VMXNET3NetworkInterface", true},
+		{"e1000e", NetworkInterfaceTypeE1000E, GroupName, "E1000ENetworkInterface", true},
+		{"sriov", NetworkInterfaceTypeSRIOV, GroupName, "SRIOVNetworkInterface", true},
+		{"e1000e with a vmxnet3 provider", NetworkInterfaceTypeE1000E, GroupName, "VMXNET3NetworkInterface", false},
+		{"e1000e with an sriov provider", NetworkInterfaceTypeE1000E, GroupName, "SRIOVNetworkInterface", false},
+		{"sriov with a vmxnet3 provider", NetworkInterfaceTypeSRIOV, GroupName, "VMXNET3NetworkInterface", false},
+		{"sriov with an e1000e provider", NetworkInterfaceTypeSRIOV, GroupName, "E1000ENetworkInterface", false},
+		{"default type with an sriov provider", "", GroupName, "SRIOVNetworkInterface", false},
+		// The kinds of other groups are not checked.
+		{"sriov with a provider of another group", NetworkInterfaceTypeSRIOV, "example.com", "Device", true},
+		{"unknown type", "e1000", GroupName, "E1000ENetworkInterface", false},
+	}
+	for _, tt := range tests {
+		ni := &NetworkInterface{
+			Spec: NetworkInterfaceSpec{
+				Type: tt.niType,
+				ProviderRef: &NetworkInterfaceProviderReference{
+					APIGroup: tt.group,
+					Kind:     tt.kind,
+					Name:     "provider",
+				},
+			},
+		}
+		err := ni.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%s: ValidateCreate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-nsxtnetwork,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=nsxtnetworks,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mnsxtnetwork.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-nsxtnetwork,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=nsxtnetworks,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vnsxtnetwork.netoperator.vmware.com
+
+// Default sets the default values of a NSXTNetwork. NSXTNetwork has no fields
+// with default values.
+func (n *NSXTNetwork) Default() {
+}
+
+// ValidateCreate validates a NSXTNetwork on creation.
+func (n *NSXTNetwork) ValidateCreate() error {
+	return invalid("NSXTNetwork", n.Name, n.validate())
+}
+
+// ValidateUpdate validates a NSXTNetwork on update.
+func (n *NSXTNetwork) ValidateUpdate(old runtime.Object) error {
+	return invalid("NSXTNetwork", n.Name, n.validate())
+}
+
+// ValidateDelete validates a NSXTNetwork on deletion.
+func (n *NSXTNetwork) ValidateDelete() error {
+	return nil
+}
+
+func (n *NSXTNetwork) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, validatePolicyPath(n.Spec.Tier1Router, specPath.Child("tier1Router"))...)
+	allErrs = append(allErrs, validatePolicyPath(n.Spec.SegmentPath, specPath.Child("segmentPath"))...)
+	for i, cidr := range n.Spec.SubnetCIDRs {
+		allErrs = append(allErrs, validateCIDR(cidr, specPath.Child("subnetCIDRs").Index(i))...)
+	}
+	if n.Spec.SNATIP != "" {
+		allErrs = append(allErrs, validateIP(n.Spec.SNATIP, "", specPath.Child("snatIP"))...)
+	}
+	return allErrs
+}
+
+// validatePolicyPath validates that s is an absolute NSX-T policy path.
+func validatePolicyPath(s string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case s == "":
+		allErrs = append(allErrs, field.Required(fldPath, ""))
+	case !strings.HasPrefix(s, "/"):
+		allErrs = append(allErrs, field.Invalid(fldPath, s, "must be an absolute policy path"))
+	}
+	return allErrs
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestNSXTNetworkValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		tier1Router string
+		segmentPath string
+		subnetCIDRs []string
+		snatIP      string
+		valid       bool
+	}{
+		{"valid", "/infra/tier-1s/t1", "/infra/segments/segment", []string{"10.0.0.0/24", "fd00::/64"}, "10.0.1.1", true},
+		{"no subnets or SNAT IP", "/infra/tier-1s/t1", "/infra/segments/segment", nil, "", true},
+		{"missing tier1Router", "", "/infra/segments/segment", nil, "", false},
+		{"relative tier1Router", "infra/tier-1s/t1", "/infra/segments/segment", nil, "", false},
+		{"missing segmentPath", "/infra/tier-1s/t1", "", nil, "", false},
+		{"relative segmentPath", "/infra/tier-1s/t1", "segment", nil, "", false},
+		{"bad subnet CIDR", "/infra/tier-1s/t1", "/infra/segments/segment", []string{"10.0.0.0/24", "10.0.0.0/33"}, "", false},
+		{"subnet without prefix", "/infra/tier-1s/t1", "/infra/segments/segment", []string{"10.0.0.0"}, "", false},
+		{"bad SNAT IP", "/infra/tier-1s/t1", "/infra/segments/segment", nil, "10.0.1", false},
+	}
+	for _, tt := range tests {
+		n := &NSXTNetwork{
+			Spec: NSXTNetworkSpec{
+				Tier1Router: tt.tier1Router,
+				SegmentPath: tt.segmentPath,
+				SubnetCIDRs: tt.subnetCIDRs,
+				SNATIP:      tt.snatIP,
+			},
+		}
+		err := n.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%s: ValidateCreate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+		if err := n.ValidateUpdate(n.DeepCopy()); (err == nil) != tt.valid {
+			t.Errorf("%s: ValidateUpdate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
+
+func TestValidatePolicyPath(t *testing.T) {
+	tests := []struct {
+		path  string
+		valid bool
+	}{
+		{"/infra/tier-1s/t1", true},
+		{"/", true},
+		{"", false},
+		{"infra/tier-1s/t1", false},
+		{" /infra/tier-1s/t1", false},
+	}
+	for _, tt := range tests {
+		errs := validatePolicyPath(tt.path, field.NewPath("spec", "tier1Router"))
+		if valid := len(errs) == 0; valid != tt.valid {
+			t.Errorf("validatePolicyPath(%q) = %v, want valid %v", tt.path, errs, tt.valid)
+		}
+	}
+}
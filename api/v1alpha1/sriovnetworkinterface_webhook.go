@@ -0,0 +1,63 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-sriovnetworkinterface,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=sriovnetworkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=msriovnetworkinterface.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-sriovnetworkinterface,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=sriovnetworkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vsriovnetworkinterface.netoperator.vmware.com
+
+const (
+	// minGuestOSMTU is the minimum MTU of an IPv4 link.
+	minGuestOSMTU = 68
+	// maxGuestOSMTU is the maximum MTU supported by vSphere.
+	maxGuestOSMTU = 9000
+)
+
+// pciAddressRegexp matches a PCI address of the form domain:bus:device.function.
+var pciAddressRegexp = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
+
+// Default sets the default values of an SRIOVNetworkInterface.
+// SRIOVNetworkInterface has no fields with default values.
+func (s *SRIOVNetworkInterface) Default() {
+}
+
+// ValidateCreate validates an SRIOVNetworkInterface on creation.
+func (s *SRIOVNetworkInterface) ValidateCreate() error {
+	return invalid("SRIOVNetworkInterface", s.Name, s.validate())
+}
+
+// ValidateUpdate validates an SRIOVNetworkInterface on update.
+func (s *SRIOVNetworkInterface) ValidateUpdate(old runtime.Object) error {
+	return invalid("SRIOVNetworkInterface", s.Name, s.validate())
+}
+
+// ValidateDelete validates an SRIOVNetworkInterface on deletion.
+func (s *SRIOVNetworkInterface) ValidateDelete() error {
+	return nil
+}
+
+func (s *SRIOVNetworkInterface) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if pf := s.Spec.PhysicalFunction; pf != "" && !pciAddressRegexp.MatchString(pf) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("physicalFunction"), pf,
+			"must be a PCI address of the form domain:bus:device.function, ex. 0000:3b:00.0"))
+	}
+	if n := s.Spec.VirtualFunctionCount; n < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("virtualFunctionCount"), n,
+			"must be greater than or equal to 0"))
+	}
+	if mtu := s.Spec.GuestOSMTU; mtu != 0 && (mtu < minGuestOSMTU || mtu > maxGuestOSMTU) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("guestOSMTU"), mtu,
+			"must be between 68 and 9000"))
+	}
+	return allErrs
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestSRIOVNetworkInterfaceValidate(t *testing.T) {
+	tests := []struct {
+		name             string
+		physicalFunction string
+		vfCount          int32
+		mtu              int32
+		valid            bool
+	}{
+		{"empty", "", 0, 0, true},
+		{"valid", "0000:3b:00.0", 8, 1500, true},
+		{"upper case PCI address", "0000:3B:0A.7", 0, 0, true},
+		{"PCI address without domain", "3b:00.0", 0, 0, false},
+		{"PCI address with function 8", "0000:3b:00.8", 0, 0, false},
+		{"PCI address with interface name", "eth0", 0, 0, false},
+		{"negative VF count", "", -1, 0, false},
+		{"MTU 67", "", 0, 67, false},
+		{"MTU 68", "", 0, 68, true},
+		{"MTU 9000", "", 0, 9000, true},
+		{"MTU 9001", "", 0, 9001, false},
+	}
+	for _, tt := range tests {
+		s := &SRIOVNetworkInterface{
+			Spec: SRIOVNetworkInterfaceSpec{
+				PhysicalFunction:     tt.physicalFunction,
+				VirtualFunctionCount: tt.vfCount,
+				GuestOSMTU:           tt.mtu,
+			},
+		}
+		err := s.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%s: ValidateCreate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+		if err := s.ValidateUpdate(s.DeepCopy()); (err == nil) != tt.valid {
+			t.Errorf("%s: ValidateUpdate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
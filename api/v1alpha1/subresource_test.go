@@ -0,0 +1,163 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/internal/testenv"
+)
+
+// statusTest describes an object whose spec and status are updated in turn.
+type statusTest struct {
+	obj runtime.Object
+	key client.ObjectKey
+	// spec and status return pointers to the spec and status of obj.
+	spec, status func() interface{}
+	// setSpec and setStatus change the spec and status of obj to the i-th
+	// of their test values.
+	setSpec, setStatus func(i int)
+}
+
+func TestStatusSubresource(t *testing.T) {
+	env := testenv.Start(t, testenv.Options{})
+	defer env.Stop(t)
+
+	ni := &v1alpha1.NetworkInterface{ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "default"}}
+	pool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+	}
+	lb := &v1alpha1.LoadBalancerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb", Namespace: "default"},
+		Spec: v1alpha1.LoadBalancerConfigSpec{
+			Type: v1alpha1.LoadBalancerConfigTypeHAProxy,
+			ProviderRef: v1alpha1.LoadBalancerConfigProviderReference{
+				APIGroup: v1alpha1.GroupName,
+				Kind:     "HAProxyLoadBalancerConfig",
+				Name:     "haproxy",
+			},
+		},
+	}
+	vdn := &v1alpha1.VSphereDistributedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+		Spec:       v1alpha1.VSphereDistributedNetworkSpec{PortGroupID: "dvportgroup-1"},
+	}
+
+	tests := map[string]statusTest{
+		"NetworkInterface": {
+			obj:    ni,
+			key:    client.ObjectKey{Namespace: "default", Name: "ni"},
+			spec:   func() interface{} { return &ni.Spec },
+			status: func() interface{} { return &ni.Status },
+			setSpec: func(i int) {
+				ni.Spec.NetworkName = []string{"network-0", "network-1"}[i]
+			},
+			setStatus: func(i int) {
+				ni.Status.MacAddress = []string{"00:50:56:00:00:00", "00:50:56:00:00:01"}[i]
+			},
+		},
+		"IPPool": {
+			obj:    pool,
+			key:    client.ObjectKey{Name: "pool"},
+			spec:   func() interface{} { return &pool.Spec },
+			status: func() interface{} { return &pool.Status },
+			setSpec: func(i int) {
+				pool.Spec.AddressCount = []int64{20, 30}[i]
+			},
+			setStatus: func(i int) {
+				pool.Status.AllocatedCount = []int64{1, 2}[i]
+			},
+		},
+		"LoadBalancerConfig": {
+			obj:    lb,
+			key:    client.ObjectKey{Namespace: "default", Name: "lb"},
+			spec:   func() interface{} { return &lb.Spec },
+			status: func() interface{} { return &lb.Status },
+			setSpec: func(i int) {
+				lb.Spec.ProviderRef.Name = []string{"haproxy-0", "haproxy-1"}[i]
+			},
+			setStatus: func(i int) {
+				lb.Status.Conditions = []v1alpha1.LoadBalancerConfigCondition{{
+					Type:   v1alpha1.LoadBalancerConfigConditionType("Ready"),
+					Status: []corev1.ConditionStatus{corev1.ConditionFalse, corev1.ConditionTrue}[i],
+				}}
+			},
+		},
+		"VSphereDistributedNetwork": {
+			obj:    vdn,
+			key:    client.ObjectKey{Name: "vdn"},
+			spec:   func() interface{} { return &vdn.Spec },
+			status: func() interface{} { return &vdn.Status },
+			setSpec: func(i int) {
+				vdn.Spec.PortGroupID = []string{"dvportgroup-2", "dvportgroup-3"}[i]
+			},
+			setStatus: func(i int) {
+				vdn.Status.Conditions = []v1alpha1.VSphereDistributedNetworkCondition{{
+					Type:   v1alpha1.VSphereDistributedNetworkPortGroupFailure,
+					Status: []corev1.ConditionStatus{corev1.ConditionFalse, corev1.ConditionTrue}[i],
+				}}
+			},
+		},
+	}
+	for kind, tt := range tests {
+		testStatusSubresource(t, env.Client, kind, tt)
+	}
+}
+
+func testStatusSubresource(t *testing.T, c client.Client, kind string, tt statusTest) {
+	ctx := context.Background()
+
+	// The status of created objects is ignored.
+	tt.setStatus(0)
+	if err := c.Create(ctx, tt.obj); err != nil {
+		t.Fatalf("%s: Create() = %v", kind, err)
+	}
+	empty := reflect.New(reflect.TypeOf(tt.status()).Elem()).Interface()
+	if !reflect.DeepEqual(tt.status(), empty) {
+		t.Errorf("%s: status of created object = %+v, want it empty", kind, tt.status())
+	}
+
+	// Updating the status does not change the spec.
+	spec := reflect.ValueOf(tt.spec()).Elem().Interface()
+	tt.setStatus(0)
+	tt.setSpec(0)
+	if err := c.Status().Update(ctx, tt.obj); err != nil {
+		t.Fatalf("%s: Status().Update() = %v", kind, err)
+	}
+	if err := c.Get(ctx, tt.key, tt.obj); err != nil {
+		t.Fatal(err)
+	}
+	if got := reflect.ValueOf(tt.spec()).Elem().Interface(); !reflect.DeepEqual(got, spec) {
+		t.Errorf("%s: Status().Update() changed the spec to %+v", kind, got)
+	}
+	status := reflect.ValueOf(tt.status()).Elem().Interface()
+	if reflect.DeepEqual(status, reflect.ValueOf(empty).Elem().Interface()) {
+		t.Errorf("%s: Status().Update() did not update the status", kind)
+	}
+
+	// Updating the spec does not change the status.
+	tt.setSpec(1)
+	tt.setStatus(1)
+	if err := c.Update(ctx, tt.obj); err != nil {
+		t.Fatalf("%s: Update() = %v", kind, err)
+	}
+	if err := c.Get(ctx, tt.key, tt.obj); err != nil {
+		t.Fatal(err)
+	}
+	if got := reflect.ValueOf(tt.status()).Elem().Interface(); !reflect.DeepEqual(got, status) {
+		t.Errorf("%s: Update() changed the status to %+v", kind, got)
+	}
+	if got := reflect.ValueOf(tt.spec()).Elem().Interface(); reflect.DeepEqual(got, spec) {
+		t.Errorf("%s: Update() did not update the spec", kind)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"math/big"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+)
+
+// invalid returns an Invalid error for the given kind and name if errs is not
+// empty, otherwise nil.
+func invalid(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: GroupName, Kind: kind}, name, errs)
+}
+
+// validateIPFamily validates that family is a known IP family.
+func validateIPFamily(family corev1.IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch family {
+	case corev1.IPv4Protocol, corev1.IPv6Protocol:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, family,
+			[]string{string(corev1.IPv4Protocol), string(corev1.IPv6Protocol)}))
+	}
+	return allErrs
+}
+
+// validateIP validates that s is an IP address. If family is not empty, the
+// address must also belong to that family.
+func validateIP(s string, family corev1.IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	_, ipFamily, ok := ipaddr.Parse(s)
+	switch {
+	case !ok:
+		allErrs = append(allErrs, field.Invalid(fldPath, s, "must be a valid IP address"))
+	case family != "" && ipFamily != family:
+		allErrs = append(allErrs, field.Invalid(fldPath, s, "must be an "+string(family)+" address"))
+	}
+	return allErrs
+}
+
+// validateCIDR validates that s is an IP prefix in CIDR notation, ex.
+// 192.168.0.0/24.
+func validateCIDR(s string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if _, _, err := net.ParseCIDR(s); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, s, "must be a valid CIDR"))
+	}
+	return allErrs
+}
+
+// validateSubnetMask validates that s is a subnet mask of the given family,
+// ex. 255.255.255.0 for IPv4 or ffff:ffff:ffff:ffff:: for IPv6.
+func validateSubnetMask(s string, family corev1.IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	ip, ipFamily, ok := ipaddr.Parse(s)
+	if !ok || (family != "" && ipFamily != family) {
+		return append(allErrs, field.Invalid(fldPath, s, "must be a valid subnet mask"))
+	}
+	if _, bits := net.IPMask(ip).Size(); bits == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, s, "must be a valid subnet mask"))
+	}
+	return allErrs
+}
+
+// validateRoutes validates that the destination of each route is a CIDR and
+// its next hop an address of the family of the destination. If family is not
+// empty, the destinations must also belong to that family.
+func validateRoutes(routes []Route, family corev1.IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, r := range routes {
+		idxPath := fldPath.Index(i)
+		ip, _, err := net.ParseCIDR(r.Destination)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("destination"), r.Destination, "must be a valid CIDR"))
+			continue
+		}
+		_, destFamily, _ := ipaddr.Parse(ip.String())
+		if family != "" && destFamily != family {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("destination"), r.Destination,
+				"must be an "+string(family)+" prefix"))
+		}
+		if r.NextHop != "" {
+			allErrs = append(allErrs, validateIP(r.NextHop, destFamily, idxPath.Child("nextHop"))...)
+		}
+		if r.Metric < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("metric"), r.Metric, "must not be negative"))
+		}
+	}
+	return allErrs
+}
+
+// validateAddressRange validates that count addresses starting at start fit
+// within the address space of the family of start.
+func validateAddressRange(start string, count int64, startPath, countPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	ip, family, ok := ipaddr.Parse(start)
+	if !ok {
+		allErrs = append(allErrs, field.Invalid(startPath, start, "must be a valid IP address"))
+	}
+	if count <= 0 {
+		allErrs = append(allErrs, field.Invalid(countPath, count, "must be greater than zero"))
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	bits := uint(128)
+	if family == corev1.IPv4Protocol {
+		bits = 32
+	}
+	last := new(big.Int).Add(ipaddr.ToInt(ip), big.NewInt(count-1))
+	if last.BitLen() > int(bits) {
+		allErrs = append(allErrs, field.Invalid(countPath, count,
+			"range starting at "+start+" exceeds the "+string(family)+" address space"))
+	}
+	return allErrs
+}
+
+// validateIPRange validates that r is a range of addresses of a single family
+// that does not end before it starts.
+func validateIPRange(r IPRange, fldPath *field.Path) field.ErrorList {
+	allErrs := validateIP(r.Start, "", fldPath.Child("start"))
+	if r.End == "" || len(allErrs) > 0 {
+		return allErrs
+	}
+	_, family, _ := ipaddr.Parse(r.Start)
+	allErrs = append(allErrs, validateIP(r.End, family, fldPath.Child("end"))...)
+	if len(allErrs) == 0 {
+		if _, _, err := r.span(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), r.End, "must not be less than start"))
+		}
+	}
+	return allErrs
+}
+
+// validateRequired validates that s is not empty.
+func validateRequired(s string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if s == "" {
+		allErrs = append(allErrs, field.Required(fldPath, ""))
+	}
+	return allErrs
+}
@@ -23,6 +23,11 @@ type VMXNET3NetworkInterfaceStatus struct {
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=vmxnet3ni,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="UPT",type="boolean",JSONPath=".spec.uptCompatibilityEnabled"
+// +kubebuilder:printcolumn:name="Wake On LAN",type="boolean",JSONPath=".spec.wakeOnLanEnabled"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // VMXNET3NetworkInterface is the Schema for the vmxnet3networkinterfaces API.
 // It represents configuration of a vSphere VMXNET3 type  network interface card.
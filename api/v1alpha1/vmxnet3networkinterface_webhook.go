@@ -0,0 +1,33 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-vmxnet3networkinterface,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=vmxnet3networkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mvmxnet3networkinterface.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-vmxnet3networkinterface,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=vmxnet3networkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vvmxnet3networkinterface.netoperator.vmware.com
+
+// Default sets the default values of a VMXNET3NetworkInterface. VMXNET3NetworkInterface has no fields with
+// default values.
+func (v *VMXNET3NetworkInterface) Default() {
+}
+
+// ValidateCreate validates a VMXNET3NetworkInterface on creation. All values
+// of VMXNET3NetworkInterfaceSpec are valid.
+func (v *VMXNET3NetworkInterface) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate validates a VMXNET3NetworkInterface on update. All values
+// of VMXNET3NetworkInterfaceSpec are valid.
+func (v *VMXNET3NetworkInterface) ValidateUpdate(old runtime.Object) error {
+	return nil
+}
+
+// ValidateDelete validates a VMXNET3NetworkInterface on deletion.
+func (v *VMXNET3NetworkInterface) ValidateDelete() error {
+	return nil
+}
@@ -8,6 +8,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// VSphereDistributedNetworkPortGroupFinalizer allows the Controller to delete the port group it created for a
+	// VSphereDistributedNetwork before removing it from the API Server.
+	VSphereDistributedNetworkPortGroupFinalizer = "vspheredistributednetwork.netoperator.vmware.com/portgroup"
+)
+
 type VSphereDistributedNetworkConditionType string
 
 const (
@@ -18,6 +24,9 @@ const (
 	VSphereDistributedNetworkIPPoolInvalid VSphereDistributedNetworkConditionType = "IPPoolInvalid"
 	// VsphereDistributedNetworkIPPoolPressure condition status is set to True when IPPool is low on free IPs.
 	VsphereDistributedNetworkIPPoolPressure VSphereDistributedNetworkConditionType = "IPPoolPressure"
+	// VSphereDistributedNetworkPortGroupDrift condition status is set to True when the configuration of the port group
+	// observed in vCenter Server differs from the VLAN, MTU or security settings of the network.
+	VSphereDistributedNetworkPortGroupDrift VSphereDistributedNetworkConditionType = "PortGroupDrift"
 )
 
 type IPAssignmentModeType string
@@ -27,6 +36,25 @@ const (
 	IPAssignmentModeDHCP IPAssignmentModeType = "dhcp"
 	// IPAssignmentModeStaticPool indicates IP address is assigned from a static pool of IP addresses.
 	IPAssignmentModeStaticPool IPAssignmentModeType = "staticpool"
+	// IPAssignmentModeSLAAC indicates IPv6 address is autoconfigured by the guest from router
+	// advertisements, see RFC 4862.
+	IPAssignmentModeSLAAC IPAssignmentModeType = "slaac"
+	// IPAssignmentModeDHCPv6 indicates IPv6 address is assigned dynamically using DHCPv6.
+	IPAssignmentModeDHCPv6 IPAssignmentModeType = "dhcpv6"
+)
+
+// DefaultIPv6PrefixLength is the default length of the network prefix of IPv6 addresses.
+const DefaultIPv6PrefixLength = 64
+
+const (
+	// MaxVLANID is the largest VLAN ID of a port group.
+	MaxVLANID = 4094
+	// MinMTU and MaxMTU are the bounds of the MTU of a network.
+	MinMTU = 1280
+	MaxMTU = 9000
+
+	// DefaultPortGroupNumPorts is the default initial number of ports of a created port group.
+	DefaultPortGroupNumPorts = 8
 )
 
 // VSphereDistributedNetworkCondition describes the state of a VSphereDistributedNetwork at a certain point.
@@ -53,12 +81,20 @@ type IPPoolReference struct {
 
 // VSphereDistributedNetworkSpec defines the desired state of VSphereDistributedNetwork.
 type VSphereDistributedNetworkSpec struct {
-	// PortGroupID is an existing vSphere Distributed PortGroup identifier.
-	PortGroupID string `json:"portGroupID"`
+	// PortGroupID is an existing vSphere Distributed PortGroup identifier. It must be set unless
+	// PortGroupCreation is set, in which case it is set to the identifier of the created port group.
+	// +optional
+	PortGroupID string `json:"portGroupID,omitempty"`
+
+	// PortGroupCreation asks for the port group of the network to be created. The port group is deleted
+	// when the network is deleted.
+	// +optional
+	PortGroupCreation *PortGroupCreation `json:"portGroupCreation,omitempty"`
 
 	// IPAssignmentMode to use for network interfaces. If unset, defaults to IPAssignmentModeStaticPool.
 	// In case of IPAssignmentModeDHCP, IPPools, Gateway and SubnetMask fields are ignored.
 	// +optional
+	// +kubebuilder:default:=staticpool
 	IPAssignmentMode IPAssignmentModeType `json:"ipAssignmentMode,omitempty"`
 
 	// IPPools references list of IPPool objects. This field should be set to empty list for
@@ -72,6 +108,114 @@ type VSphereDistributedNetworkSpec struct {
 	// SubnetMask setting to use for network interfaces. This field should be set to empty string
 	// for IPAssignmentModeDHCP IPAssignmentMode.
 	SubnetMask string `json:"subnetMask"`
+
+	// Routes is the list of static routes of the network. Each route is added to the IPConfig of
+	// network interfaces whose IP is of the family of the route's destination.
+	// +optional
+	Routes []Route `json:"routes,omitempty"`
+
+	// IPv6 is the IPv6 configuration of a dual-stack network. If set, IPAssignmentMode, IPPools,
+	// Gateway and SubnetMask only apply to the IPv4 addresses of network interfaces.
+	// +optional
+	IPv6 *IPv6Config `json:"ipv6,omitempty"`
+
+	// VLANID is the VLAN ID of the port group, 0 for untagged traffic. VLANID and VLANTrunkRanges are
+	// mutually exclusive. The VLAN of the port group is left as configured in vCenter Server if
+	// neither is set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4094
+	VLANID *int32 `json:"vlanID,omitempty"`
+
+	// VLANTrunkRanges are the ranges of VLAN IDs trunked to the network interfaces of the port group.
+	// +optional
+	VLANTrunkRanges []VLANRange `json:"vlanTrunkRanges,omitempty"`
+
+	// MTU is the maximum transmission unit of the network, in bytes. The MTU is a setting of the
+	// distributed switch of the port group and is shared by all the port groups of the switch.
+	// +optional
+	// +kubebuilder:validation:Minimum=1280
+	// +kubebuilder:validation:Maximum=9000
+	MTU int32 `json:"mtu,omitempty"`
+
+	// Security is the security policy of the port group.
+	// +optional
+	Security *PortGroupSecurity `json:"security,omitempty"`
+}
+
+// PortGroupCreation describes the vSphere Distributed PortGroup to create for a network.
+type PortGroupCreation struct {
+	// DistributedSwitchID is the identifier of the vSphere Distributed Switch on which the port group
+	// is created.
+	DistributedSwitchID string `json:"distributedSwitchID"`
+
+	// Name of the port group. If unset, defaults to the name of the network.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// NumPorts is the initial number of ports of the port group, which expands automatically. If
+	// unset, defaults to DefaultPortGroupNumPorts.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	NumPorts int32 `json:"numPorts,omitempty"`
+}
+
+// VLANRange is a range of VLAN IDs, from Start to End inclusive.
+type VLANRange struct {
+	// Start is the first VLAN ID of the range.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4094
+	Start int32 `json:"start"`
+
+	// End is the last VLAN ID of the range.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4094
+	End int32 `json:"end"`
+}
+
+// PortGroupSecurity is the security policy of a port group. The settings that are not set are left
+// as configured in vCenter Server.
+type PortGroupSecurity struct {
+	// AllowPromiscuous allows network interfaces to receive all the traffic of the port group.
+	// +optional
+	AllowPromiscuous *bool `json:"allowPromiscuous,omitempty"`
+
+	// MACChanges allows network interfaces to receive traffic for a MAC address other than the
+	// one they were assigned.
+	// +optional
+	MACChanges *bool `json:"macChanges,omitempty"`
+
+	// ForgedTransmits allows network interfaces to send traffic from a MAC address other than the
+	// one they were assigned.
+	// +optional
+	ForgedTransmits *bool `json:"forgedTransmits,omitempty"`
+}
+
+// IPv6Config describes how IPv6 addresses are assigned to the network interfaces of a dual-stack
+// network.
+type IPv6Config struct {
+	// IPAssignmentMode to use for the IPv6 addresses of network interfaces, one of
+	// IPAssignmentModeStaticPool, IPAssignmentModeSLAAC and IPAssignmentModeDHCPv6. If unset,
+	// defaults to IPAssignmentModeStaticPool. IPPools, Gateway and PrefixLength are ignored unless
+	// IPAssignmentModeStaticPool is used.
+	// +optional
+	// +kubebuilder:default:=staticpool
+	IPAssignmentMode IPAssignmentModeType `json:"ipAssignmentMode,omitempty"`
+
+	// IPPools references list of IPPool objects of IPv6 addresses.
+	// +optional
+	IPPools []IPPoolReference `json:"ipPools,omitempty"`
+
+	// Gateway is the IPv6 gateway to use for network interfaces.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+
+	// PrefixLength is the length of the network prefix of the IPv6 addresses of network
+	// interfaces. If unset, defaults to DefaultIPv6PrefixLength.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	PrefixLength int32 `json:"prefixLength,omitempty"`
 }
 
 // VSphereDistributedNetworkStatus defines the observed state of VSphereDistributedNetwork.
@@ -83,7 +227,16 @@ type VSphereDistributedNetworkStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=vdnet,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Port Group",type="string",JSONPath=".spec.portGroupID"
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.ipAssignmentMode"
+// +kubebuilder:printcolumn:name="Gateway",type="string",JSONPath=".spec.gateway"
+// +kubebuilder:printcolumn:name="VLAN",type="integer",JSONPath=".spec.vlanID",priority=1
+// +kubebuilder:printcolumn:name="IPv6 Mode",type="string",JSONPath=".spec.ipv6.ipAssignmentMode",priority=1
+// +kubebuilder:printcolumn:name="Pool Pressure",type="string",JSONPath=".status.conditions[?(@.type==\"IPPoolPressure\")].status",priority=1
+// +kubebuilder:printcolumn:name="Drift",type="string",JSONPath=".status.conditions[?(@.type==\"PortGroupDrift\")].status",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // VSphereDistributedNetwork represents schema for a network backed by a vSphere Distributed PortGroup on vSphere
 // Distributed switch.
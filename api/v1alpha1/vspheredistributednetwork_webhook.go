@@ -0,0 +1,178 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// +kubebuilder:webhook:path=/mutate-netoperator-vmware-com-v1alpha1-vspheredistributednetwork,mutating=true,failurePolicy=fail,groups=netoperator.vmware.com,resources=vspheredistributednetworks,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=mvspheredistributednetwork.netoperator.vmware.com
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-vspheredistributednetwork,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=vspheredistributednetworks,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vvspheredistributednetwork.netoperator.vmware.com
+
+// Default sets the default values of a VSphereDistributedNetwork.
+func (n *VSphereDistributedNetwork) Default() {
+	if n.Spec.IPAssignmentMode == "" {
+		n.Spec.IPAssignmentMode = IPAssignmentModeStaticPool
+	}
+	if v6 := n.Spec.IPv6; v6 != nil {
+		if v6.IPAssignmentMode == "" {
+			v6.IPAssignmentMode = IPAssignmentModeStaticPool
+		}
+		if v6.PrefixLength == 0 {
+			v6.PrefixLength = DefaultIPv6PrefixLength
+		}
+	}
+}
+
+// ValidateCreate validates a VSphereDistributedNetwork on creation.
+func (n *VSphereDistributedNetwork) ValidateCreate() error {
+	allErrs := n.validate()
+	if n.Spec.PortGroupCreation != nil && n.Spec.PortGroupID != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "portGroupID"),
+			"must be empty when portGroupCreation is set"))
+	}
+	return invalid("VSphereDistributedNetwork", n.Name, allErrs)
+}
+
+// ValidateUpdate validates a VSphereDistributedNetwork on update. The
+// PortGroupCreation of a network is immutable, and so is the PortGroupID of
+// the port group created for it.
+func (n *VSphereDistributedNetwork) ValidateUpdate(old runtime.Object) error {
+	allErrs := n.validate()
+	if oldNetwork, ok := old.(*VSphereDistributedNetwork); ok {
+		specPath := field.NewPath("spec")
+		if !reflect.DeepEqual(n.Spec.PortGroupCreation, oldNetwork.Spec.PortGroupCreation) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("portGroupCreation"), "field is immutable"))
+		}
+		if oldNetwork.Spec.PortGroupCreation != nil && oldNetwork.Spec.PortGroupID != "" &&
+			n.Spec.PortGroupID != oldNetwork.Spec.PortGroupID {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("portGroupID"),
+				"must not change once the port group is created"))
+		}
+	}
+	return invalid("VSphereDistributedNetwork", n.Name, allErrs)
+}
+
+// ValidateDelete validates a VSphereDistributedNetwork on deletion.
+func (n *VSphereDistributedNetwork) ValidateDelete() error {
+	return nil
+}
+
+func (n *VSphereDistributedNetwork) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if c := n.Spec.PortGroupCreation; c != nil {
+		creationPath := specPath.Child("portGroupCreation")
+		allErrs = append(allErrs, validateRequired(c.DistributedSwitchID, creationPath.Child("distributedSwitchID"))...)
+		if c.NumPorts < 0 {
+			allErrs = append(allErrs, field.Invalid(creationPath.Child("numPorts"), c.NumPorts,
+				"must be greater than or equal to 0"))
+		}
+	} else {
+		allErrs = append(allErrs, validateRequired(n.Spec.PortGroupID, specPath.Child("portGroupID"))...)
+	}
+
+	// The addresses configured outside of ipv6 are IPv4 addresses in a dual-stack network.
+	var family corev1.IPFamily
+	if n.Spec.IPv6 != nil {
+		family = corev1.IPv4Protocol
+	}
+
+	switch n.Spec.IPAssignmentMode {
+	case "", IPAssignmentModeStaticPool:
+		for i, ref := range n.Spec.IPPools {
+			allErrs = append(allErrs, validateRequired(ref.Name, specPath.Child("ipPools").Index(i).Child("name"))...)
+		}
+		if n.Spec.Gateway != "" {
+			allErrs = append(allErrs, validateIP(n.Spec.Gateway, family, specPath.Child("gateway"))...)
+		}
+		if n.Spec.SubnetMask != "" {
+			allErrs = append(allErrs, validateSubnetMask(n.Spec.SubnetMask, family, specPath.Child("subnetMask"))...)
+		}
+	case IPAssignmentModeDHCP:
+		if len(n.Spec.IPPools) > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("ipPools"),
+				"must be empty when ipAssignmentMode is "+string(IPAssignmentModeDHCP)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("ipAssignmentMode"), n.Spec.IPAssignmentMode,
+			[]string{string(IPAssignmentModeDHCP), string(IPAssignmentModeStaticPool)}))
+	}
+
+	if v6 := n.Spec.IPv6; v6 != nil {
+		allErrs = append(allErrs, validateIPv6Config(v6, specPath.Child("ipv6"))...)
+	}
+	allErrs = append(allErrs, validateRoutes(n.Spec.Routes, "", specPath.Child("routes"))...)
+
+	if n.Spec.VLANID != nil {
+		if *n.Spec.VLANID < 0 || *n.Spec.VLANID > MaxVLANID {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("vlanID"), *n.Spec.VLANID,
+				fmt.Sprintf("must be between 0 and %d", MaxVLANID)))
+		}
+		if len(n.Spec.VLANTrunkRanges) > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("vlanTrunkRanges"),
+				"must be empty when vlanID is set"))
+		}
+	}
+	for i, r := range n.Spec.VLANTrunkRanges {
+		allErrs = append(allErrs, validateVLANRange(r, specPath.Child("vlanTrunkRanges").Index(i))...)
+	}
+	if n.Spec.MTU != 0 && (n.Spec.MTU < MinMTU || n.Spec.MTU > MaxMTU) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("mtu"), n.Spec.MTU,
+			fmt.Sprintf("must be between %d and %d", MinMTU, MaxMTU)))
+	}
+	return allErrs
+}
+
+// validateVLANRange validates a range of VLAN IDs.
+func validateVLANRange(r VLANRange, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, id := range []struct {
+		name  string
+		value int32
+	}{{"start", r.Start}, {"end", r.End}} {
+		if id.value < 0 || id.value > MaxVLANID {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(id.name), id.value,
+				fmt.Sprintf("must be between 0 and %d", MaxVLANID)))
+		}
+	}
+	if r.End < r.Start {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), r.End, "must not be less than start"))
+	}
+	return allErrs
+}
+
+// validateIPv6Config validates the IPv6 configuration of a dual-stack network.
+func validateIPv6Config(c *IPv6Config, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch c.IPAssignmentMode {
+	case "", IPAssignmentModeStaticPool:
+		for i, ref := range c.IPPools {
+			allErrs = append(allErrs, validateRequired(ref.Name, fldPath.Child("ipPools").Index(i).Child("name"))...)
+		}
+		if c.Gateway != "" {
+			allErrs = append(allErrs, validateIP(c.Gateway, corev1.IPv6Protocol, fldPath.Child("gateway"))...)
+		}
+		if c.PrefixLength < 0 || c.PrefixLength > 8*net.IPv6len {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("prefixLength"), c.PrefixLength,
+				"must be between 0 (default) and 128"))
+		}
+	case IPAssignmentModeSLAAC, IPAssignmentModeDHCPv6:
+		if len(c.IPPools) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipPools"),
+				"must be empty when ipAssignmentMode is "+string(c.IPAssignmentMode)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("ipAssignmentMode"), c.IPAssignmentMode,
+			[]string{string(IPAssignmentModeStaticPool), string(IPAssignmentModeSLAAC), string(IPAssignmentModeDHCPv6)}))
+	}
+	return allErrs
+}
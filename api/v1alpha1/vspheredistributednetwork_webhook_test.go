@@ -0,0 +1,82 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestVSphereDistributedNetworkValidateDualStack(t *testing.T) {
+	tests := []struct {
+		name       string
+		gateway    string
+		subnetMask string
+		ipv6       *IPv6Config
+		valid      bool
+	}{
+		{"IPv4", "192.168.1.1", "255.255.255.0", nil, true},
+		{"IPv6 only", "fd00::1", "ffff:ffff:ffff:ffff::", nil, true},
+		{"dual-stack", "192.168.1.1", "255.255.255.0", &IPv6Config{Gateway: "fd00::1", PrefixLength: 64}, true},
+		{"SLAAC", "192.168.1.1", "255.255.255.0", &IPv6Config{IPAssignmentMode: IPAssignmentModeSLAAC}, true},
+		{"DHCPv6", "192.168.1.1", "255.255.255.0", &IPv6Config{IPAssignmentMode: IPAssignmentModeDHCPv6}, true},
+		{"IPv6 gateway outside ipv6", "fd00::1", "255.255.255.0", &IPv6Config{}, false},
+		{"IPv4 gateway in ipv6", "192.168.1.1", "255.255.255.0", &IPv6Config{Gateway: "192.168.1.2"}, false},
+		{"prefix length", "192.168.1.1", "255.255.255.0", &IPv6Config{PrefixLength: 129}, false},
+		{"SLAAC with pools", "192.168.1.1", "255.255.255.0", &IPv6Config{
+			IPAssignmentMode: IPAssignmentModeSLAAC,
+			IPPools:          []IPPoolReference{{Name: "pool6"}},
+		}, false},
+		{"DHCP for IPv6", "192.168.1.1", "255.255.255.0", &IPv6Config{IPAssignmentMode: IPAssignmentModeDHCP}, false},
+	}
+	for _, tt := range tests {
+		n := &VSphereDistributedNetwork{
+			Spec: VSphereDistributedNetworkSpec{
+				PortGroupID: "dvportgroup-1",
+				Gateway:     tt.gateway,
+				SubnetMask:  tt.subnetMask,
+				IPv6:        tt.ipv6,
+			},
+		}
+		err := n.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%s: ValidateCreate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
+
+func TestVSphereDistributedNetworkValidatePortGroupSettings(t *testing.T) {
+	vlanID := func(id int32) *int32 { return &id }
+	tests := []struct {
+		name   string
+		vlanID *int32
+		trunk  []VLANRange
+		mtu    int32
+		valid  bool
+	}{
+		{"untagged", vlanID(0), nil, 0, true},
+		{"VLAN", vlanID(MaxVLANID), nil, MaxMTU, true},
+		{"trunk", nil, []VLANRange{{Start: 1, End: 10}, {Start: 20, End: 20}}, MinMTU, true},
+		{"VLAN out of range", vlanID(MaxVLANID + 1), nil, 0, false},
+		{"negative VLAN", vlanID(-1), nil, 0, false},
+		{"VLAN and trunk", vlanID(10), []VLANRange{{Start: 1, End: 10}}, 0, false},
+		{"reversed trunk range", nil, []VLANRange{{Start: 10, End: 1}}, 0, false},
+		{"trunk out of range", nil, []VLANRange{{Start: 1, End: MaxVLANID + 1}}, 0, false},
+		{"MTU too small", nil, nil, MinMTU - 1, false},
+		{"MTU too large", nil, nil, MaxMTU + 1, false},
+	}
+	for _, tt := range tests {
+		n := &VSphereDistributedNetwork{
+			Spec: VSphereDistributedNetworkSpec{
+				PortGroupID:     "dvportgroup-1",
+				VLANID:          tt.vlanID,
+				VLANTrunkRanges: tt.trunk,
+				MTU:             tt.mtu,
+			},
+		}
+		err := n.ValidateCreate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%s: ValidateCreate() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
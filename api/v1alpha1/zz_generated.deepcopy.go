@@ -1,4 +1,4 @@
-// +build !ignore_autogenerated
+//go:build !ignore_autogenerated
 
 // Copyright (c) 2020 VMware, Inc. All Rights Reserved.
 // SPDX-License-Identifier: Apache-2.0
@@ -121,6 +121,95 @@ func (in *ClientSecretReference) DeepCopy() *ClientSecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *E1000ENetworkInterface) DeepCopyInto(out *E1000ENetworkInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new E1000ENetworkInterface.
+func (in *E1000ENetworkInterface) DeepCopy() *E1000ENetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(E1000ENetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *E1000ENetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *E1000ENetworkInterfaceList) DeepCopyInto(out *E1000ENetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]E1000ENetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new E1000ENetworkInterfaceList.
+func (in *E1000ENetworkInterfaceList) DeepCopy() *E1000ENetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(E1000ENetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *E1000ENetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *E1000ENetworkInterfaceSpec) DeepCopyInto(out *E1000ENetworkInterfaceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new E1000ENetworkInterfaceSpec.
+func (in *E1000ENetworkInterfaceSpec) DeepCopy() *E1000ENetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(E1000ENetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *E1000ENetworkInterfaceStatus) DeepCopyInto(out *E1000ENetworkInterfaceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new E1000ENetworkInterfaceStatus.
+func (in *E1000ENetworkInterfaceStatus) DeepCopy() *E1000ENetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(E1000ENetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HAProxyLoadBalancerConfig) DeepCopyInto(out *HAProxyLoadBalancerConfig) {
 	*out = *in
@@ -219,6 +308,16 @@ func (in *HAProxyLoadBalancerConfigStatus) DeepCopy() *HAProxyLoadBalancerConfig
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPConfig) DeepCopyInto(out *IPConfig) {
 	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPConfig.
@@ -236,7 +335,7 @@ func (in *IPPool) DeepCopyInto(out *IPPool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -258,6 +357,22 @@ func (in *IPPool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolAllocation) DeepCopyInto(out *IPPoolAllocation) {
+	*out = *in
+	out.NetworkInterfaceRef = in.NetworkInterfaceRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolAllocation.
+func (in *IPPoolAllocation) DeepCopy() *IPPoolAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolCondition) DeepCopyInto(out *IPPoolCondition) {
 	*out = *in
@@ -273,6 +388,22 @@ func (in *IPPoolCondition) DeepCopy() *IPPoolCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolHeldAddress) DeepCopyInto(out *IPPoolHeldAddress) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolHeldAddress.
+func (in *IPPoolHeldAddress) DeepCopy() *IPPoolHeldAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolHeldAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
 	*out = *in
@@ -323,6 +454,26 @@ func (in *IPPoolReference) DeepCopy() *IPPoolReference {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
 	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]IPRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]IPRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.StickyAllocation != nil {
+		in, out := &in.StickyAllocation, &out.StickyAllocation
+		*out = new(StickyAllocation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolSpec.
@@ -343,6 +494,18 @@ func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
 		*out = make([]IPPoolCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.Allocations != nil {
+		in, out := &in.Allocations, &out.Allocations
+		*out = make([]IPPoolAllocation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Held != nil {
+		in, out := &in.Held, &out.Held
+		*out = make([]IPPoolHeldAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolStatus.
@@ -355,6 +518,41 @@ func (in *IPPoolStatus) DeepCopy() *IPPoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRange) DeepCopyInto(out *IPRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
+func (in *IPRange) DeepCopy() *IPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6Config) DeepCopyInto(out *IPv6Config) {
+	*out = *in
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]IPPoolReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6Config.
+func (in *IPv6Config) DeepCopy() *IPv6Config {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
 	*out = *in
@@ -483,6 +681,123 @@ func (in *LoadBalancerConfigStatus) DeepCopy() *LoadBalancerConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXTNetwork) DeepCopyInto(out *NSXTNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXTNetwork.
+func (in *NSXTNetwork) DeepCopy() *NSXTNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXTNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NSXTNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXTNetworkCondition) DeepCopyInto(out *NSXTNetworkCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXTNetworkCondition.
+func (in *NSXTNetworkCondition) DeepCopy() *NSXTNetworkCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXTNetworkCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXTNetworkList) DeepCopyInto(out *NSXTNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NSXTNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXTNetworkList.
+func (in *NSXTNetworkList) DeepCopy() *NSXTNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXTNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NSXTNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXTNetworkSpec) DeepCopyInto(out *NSXTNetworkSpec) {
+	*out = *in
+	if in.SubnetCIDRs != nil {
+		in, out := &in.SubnetCIDRs, &out.SubnetCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXTNetworkSpec.
+func (in *NSXTNetworkSpec) DeepCopy() *NSXTNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXTNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXTNetworkStatus) DeepCopyInto(out *NSXTNetworkStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]NSXTNetworkCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXTNetworkStatus.
+func (in *NSXTNetworkStatus) DeepCopy() *NSXTNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXTNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -615,6 +930,21 @@ func (in *NetworkInterfaceProviderReference) DeepCopy() *NetworkInterfaceProvide
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceReference) DeepCopyInto(out *NetworkInterfaceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceReference.
+func (in *NetworkInterfaceReference) DeepCopy() *NetworkInterfaceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
 	*out = *in
@@ -628,6 +958,16 @@ func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
 		*out = new(NetworkInterfacePortAllocation)
 		**out = **in
 	}
+	if in.RequestedIPConfigs != nil {
+		in, out := &in.RequestedIPConfigs, &out.RequestedIPConfigs
+		*out = make([]RequestedIPConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultRoute != nil {
+		in, out := &in.DefaultRoute, &out.DefaultRoute
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
@@ -653,7 +993,9 @@ func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
 	if in.IPConfigs != nil {
 		in, out := &in.IPConfigs, &out.IPConfigs
 		*out = make([]IPConfig, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -760,6 +1102,201 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortGroupCreation) DeepCopyInto(out *PortGroupCreation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortGroupCreation.
+func (in *PortGroupCreation) DeepCopy() *PortGroupCreation {
+	if in == nil {
+		return nil
+	}
+	out := new(PortGroupCreation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortGroupSecurity) DeepCopyInto(out *PortGroupSecurity) {
+	*out = *in
+	if in.AllowPromiscuous != nil {
+		in, out := &in.AllowPromiscuous, &out.AllowPromiscuous
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MACChanges != nil {
+		in, out := &in.MACChanges, &out.MACChanges
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForgedTransmits != nil {
+		in, out := &in.ForgedTransmits, &out.ForgedTransmits
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortGroupSecurity.
+func (in *PortGroupSecurity) DeepCopy() *PortGroupSecurity {
+	if in == nil {
+		return nil
+	}
+	out := new(PortGroupSecurity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestedIPConfig) DeepCopyInto(out *RequestedIPConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestedIPConfig.
+func (in *RequestedIPConfig) DeepCopy() *RequestedIPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestedIPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRIOVNetworkInterface) DeepCopyInto(out *SRIOVNetworkInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRIOVNetworkInterface.
+func (in *SRIOVNetworkInterface) DeepCopy() *SRIOVNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(SRIOVNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SRIOVNetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRIOVNetworkInterfaceList) DeepCopyInto(out *SRIOVNetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SRIOVNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRIOVNetworkInterfaceList.
+func (in *SRIOVNetworkInterfaceList) DeepCopy() *SRIOVNetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SRIOVNetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SRIOVNetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRIOVNetworkInterfaceSpec) DeepCopyInto(out *SRIOVNetworkInterfaceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRIOVNetworkInterfaceSpec.
+func (in *SRIOVNetworkInterfaceSpec) DeepCopy() *SRIOVNetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SRIOVNetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRIOVNetworkInterfaceStatus) DeepCopyInto(out *SRIOVNetworkInterfaceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRIOVNetworkInterfaceStatus.
+func (in *SRIOVNetworkInterfaceStatus) DeepCopy() *SRIOVNetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SRIOVNetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StickyAllocation) DeepCopyInto(out *StickyAllocation) {
+	*out = *in
+	out.GracePeriod = in.GracePeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StickyAllocation.
+func (in *StickyAllocation) DeepCopy() *StickyAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(StickyAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANRange) DeepCopyInto(out *VLANRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANRange.
+func (in *VLANRange) DeepCopy() *VLANRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMXNET3NetworkInterface) DeepCopyInto(out *VMXNET3NetworkInterface) {
 	*out = *in
@@ -927,11 +1464,41 @@ func (in *VSphereDistributedNetworkList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSphereDistributedNetworkSpec) DeepCopyInto(out *VSphereDistributedNetworkSpec) {
 	*out = *in
+	if in.PortGroupCreation != nil {
+		in, out := &in.PortGroupCreation, &out.PortGroupCreation
+		*out = new(PortGroupCreation)
+		**out = **in
+	}
 	if in.IPPools != nil {
 		in, out := &in.IPPools, &out.IPPools
 		*out = make([]IPPoolReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPv6Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VLANID != nil {
+		in, out := &in.VLANID, &out.VLANID
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VLANTrunkRanges != nil {
+		in, out := &in.VLANTrunkRanges, &out.VLANTrunkRanges
+		*out = make([]VLANRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(PortGroupSecurity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedNetworkSpec.
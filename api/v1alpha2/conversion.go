@@ -0,0 +1,40 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+// v1alpha2 is the hub version of every kind. The other versions implement
+// conversion to and from v1alpha2.
+
+// Hub marks AviLoadBalancerConfig as a conversion hub.
+func (*AviLoadBalancerConfig) Hub() {}
+
+// Hub marks E1000ENetworkInterface as a conversion hub.
+func (*E1000ENetworkInterface) Hub() {}
+
+// Hub marks HAProxyLoadBalancerConfig as a conversion hub.
+func (*HAProxyLoadBalancerConfig) Hub() {}
+
+// Hub marks IPPool as a conversion hub.
+func (*IPPool) Hub() {}
+
+// Hub marks LoadBalancerConfig as a conversion hub.
+func (*LoadBalancerConfig) Hub() {}
+
+// Hub marks Network as a conversion hub.
+func (*Network) Hub() {}
+
+// Hub marks NetworkInterface as a conversion hub.
+func (*NetworkInterface) Hub() {}
+
+// Hub marks NSXTNetwork as a conversion hub.
+func (*NSXTNetwork) Hub() {}
+
+// Hub marks SRIOVNetworkInterface as a conversion hub.
+func (*SRIOVNetworkInterface) Hub() {}
+
+// Hub marks VMXNET3NetworkInterface as a conversion hub.
+func (*VMXNET3NetworkInterface) Hub() {}
+
+// Hub marks VSphereDistributedNetwork as a conversion hub.
+func (*VSphereDistributedNetwork) Hub() {}
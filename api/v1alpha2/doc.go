@@ -0,0 +1,7 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:openapi-gen=true
+// +kubebuilder:object:generate=true
+// +groupName=netoperator.vmware.com
+package v1alpha2
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// E1000ENetworkInterfaceSpec defines the desired state of E1000ENetworkInterface.
+type E1000ENetworkInterfaceSpec struct {
+	// WakeOnLanEnabled indicates whether wake-on-LAN is enabled on this network interface. Clients
+	// can set this property to selectively enable or disable wake-on-LAN.
+	WakeOnLanEnabled bool `json:"wakeOnLanEnabled,omitempty"`
+}
+
+// E1000ENetworkInterfaceStatus is unused. E1000ENetworkInterface is a configuration only resource.
+type E1000ENetworkInterfaceStatus struct {
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=e1000eni,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Wake On LAN",type="boolean",JSONPath=".spec.wakeOnLanEnabled"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// E1000ENetworkInterface is the Schema for the e1000enetworkinterfaces API.
+// It represents configuration of a vSphere E1000E type network interface card, for guests
+// without a VMXNET3 driver.
+type E1000ENetworkInterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   E1000ENetworkInterfaceSpec   `json:"spec,omitempty"`
+	Status E1000ENetworkInterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// E1000ENetworkInterfaceList contains a list of E1000ENetworkInterface
+type E1000ENetworkInterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []E1000ENetworkInterface `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&E1000ENetworkInterface{}, &E1000ENetworkInterfaceList{})
+}
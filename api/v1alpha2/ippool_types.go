@@ -0,0 +1,193 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// IPAMDisabledAnnotationKeyName is the name of the annotation added to
+// GatewayClass resources that do not participate in net-operator's IPAM.
+// The value does not need to be truthy; the presence of the key is what
+// disables net-operator's IPAM for that GatewayClass.
+const IPAMDisabledAnnotationKeyName = "netoperator.vmware.com/ipam-disabled"
+
+type IPPoolConditionType string
+
+const (
+	// IPPoolFull condition is added when no more IPs are free in the pool.
+	IPPoolFull IPPoolConditionType = "full"
+	// IPPoolReady condition is added when IPPool has been realized.
+	IPPoolReady IPPoolConditionType = "ready"
+	// IPPoolFail condition is added when an error was encountered in realizing.
+	IPPoolFail IPPoolConditionType = "failure"
+)
+
+// IPPoolCondition describes the state of a IPPool at a certain point.
+type IPPoolCondition struct {
+	// Type is the type of IPPool condition.
+	Type IPPoolConditionType `json:"type"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Machine understandable string that gives the reason for condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message string `json:"message,omitempty"`
+}
+
+// IPPoolSpec defines the desired state of IPPool
+type IPPoolSpec struct {
+	// Ranges is the list of ranges of IP addresses in the pool.
+	// +optional
+	Ranges []IPRange `json:"ranges,omitempty"`
+	// CIDRs is the list of IP prefixes in CIDR notation, ex. 192.168.1.0/24, whose addresses are in
+	// the pool. The network and broadcast addresses of IPv4 prefixes shorter than /31 are not in
+	// the pool.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
+	// Exclusions is the list of ranges of IP addresses that are not in the pool even though they
+	// are within one of its ranges or CIDRs, ex. the addresses of infrastructure devices.
+	// +optional
+	Exclusions []IPRange `json:"exclusions,omitempty"`
+	// StickyAllocation enables sticky allocation of the addresses of the pool. If unset, the address
+	// of a NetworkInterface is freed when the NetworkInterface is deleted.
+	// +optional
+	StickyAllocation *StickyAllocation `json:"stickyAllocation,omitempty"`
+}
+
+// IPRange describes a contiguous range of IP addresses. All the addresses of an IPPool must be of
+// the same family.
+type IPRange struct {
+	// Start is the first IP address of the range.
+	Start string `json:"start"`
+	// End is the last IP address of the range. It must be of the same family as Start and not
+	// less than Start. If unset, the range only contains Start.
+	// +optional
+	End string `json:"end,omitempty"`
+}
+
+// StickyAllocation describes how released addresses are held for the identity of their owner.
+// The identity of a NetworkInterface is stable across its recreation, so that a recreated
+// NetworkInterface gets the address of the NetworkInterface it replaces.
+type StickyAllocation struct {
+	// IdentityLabel is the key of the NetworkInterface label whose value, together with the
+	// namespace of the NetworkInterface, is its identity. If unset, the kind and name of the
+	// controller owner of the NetworkInterface, ex. a VirtualMachine, are its identity.
+	// NetworkInterfaces without an identity are allocated addresses that are not sticky.
+	// +optional
+	IdentityLabel string `json:"identityLabel,omitempty"`
+	// GracePeriod is how long the address of a deleted NetworkInterface is held for its identity
+	// before it is freed. Defaults to 30m.
+	// +optional
+	GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// NetworkInterfaceReference contains info to locate a NetworkInterface object, or the object of
+// another kind that owns an IP address, ex. a Cluster API IPAddressClaim.
+type NetworkInterfaceReference struct {
+	// APIGroup is the group of the object being referenced. It is empty for a NetworkInterface.
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+	// Kind is the kind of the object being referenced. It is empty for a NetworkInterface.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the NetworkInterface being referenced.
+	Name string `json:"name"`
+	// Namespace is the namespace of the NetworkInterface being referenced.
+	Namespace string `json:"namespace"`
+	// UID is the UID of the NetworkInterface being referenced.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+}
+
+// IPPoolAllocation describes an IP address allocated from an IPPool.
+type IPPoolAllocation struct {
+	// IP is the allocated IP address.
+	IP string `json:"ip"`
+	// NetworkInterfaceRef is a reference to the NetworkInterface that owns the IP address.
+	NetworkInterfaceRef NetworkInterfaceReference `json:"networkInterfaceRef"`
+	// Identity is the sticky allocation identity of the NetworkInterface that owns the IP address.
+	// It is empty if the allocation is not sticky.
+	// +optional
+	Identity string `json:"identity,omitempty"`
+}
+
+// IPPoolHeldAddress describes a released IP address that is held for the identity of its
+// previous owner.
+type IPPoolHeldAddress struct {
+	// IP is the held IP address.
+	IP string `json:"ip"`
+	// Identity is the sticky allocation identity the IP address is held for.
+	Identity string `json:"identity"`
+	// ExpirationTime is the time after which the IP address is freed.
+	ExpirationTime metav1.Time `json:"expirationTime"`
+}
+
+// IPPoolStatus defines the current state of IPPool.
+type IPPoolStatus struct {
+	// Conditions is an array of current observed IPPool conditions.
+	Conditions []IPPoolCondition `json:"conditions,omitempty"`
+	// AddressCount is the number of IP addresses in the pool, excluding its exclusions.
+	// +optional
+	AddressCount int64 `json:"addressCount,omitempty"`
+	// AllocatedCount is the number of IP addresses allocated from the pool.
+	// +optional
+	AllocatedCount int64 `json:"allocatedCount,omitempty"`
+	// FreeCount is the number of IP addresses that are available for allocation.
+	// +optional
+	FreeCount int64 `json:"freeCount,omitempty"`
+	// Allocations is the list of IP addresses allocated from the pool and the
+	// NetworkInterface that owns each of them.
+	// +optional
+	Allocations []IPPoolAllocation `json:"allocations,omitempty"`
+	// HeldCount is the number of released IP addresses that are held for the identity of their
+	// previous owner. Held addresses are not free.
+	// +optional
+	HeldCount int64 `json:"heldCount,omitempty"`
+	// Held is the list of released IP addresses that are held for the identity of their previous
+	// owner until they expire.
+	// +optional
+	Held []IPPoolHeldAddress `json:"held,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=ipp,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".status.addressCount"
+// +kubebuilder:printcolumn:name="Allocated",type="integer",JSONPath=".status.allocatedCount"
+// +kubebuilder:printcolumn:name="Free",type="integer",JSONPath=".status.freeCount"
+// +kubebuilder:printcolumn:name="Held",type="integer",JSONPath=".status.heldCount",priority=1
+// +kubebuilder:printcolumn:name="Full",type="string",JSONPath=".status.conditions[?(@.type==\"full\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// IPPool is the Schema for the ippools API.
+// It represents a pool of IP addresses that are owned and managed by the IPPool controller.
+// Provider specific networks can associate themselves with IPPool objects to use
+// network operator's IPAM implementation.
+type IPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPPoolSpec   `json:"spec,omitempty"`
+	Status IPPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPPoolList contains a list of IPPool
+type IPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPPool `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&IPPool{}, &IPPoolList{})
+}
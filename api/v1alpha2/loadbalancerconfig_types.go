@@ -0,0 +1,113 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClientSecretDefaultNamespace is the default value of ClientSecretReference.Namespace.
+const ClientSecretDefaultNamespace = "default"
+
+// ClientSecretReference contains info to locate an object of Kind Secret
+// which contains credential specifications for a load balancer.
+type ClientSecretReference struct {
+	// Name is the name of resource being referenced.
+	Name string `json:"name"`
+	// Namespace of the resource being referenced. If empty, cluster scoped
+	// resource is assumed.
+	// +kubebuilder:default:=default
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// LoadBalancerConfigConditionType is used as a typed string for representing
+// LoadBalancerConfig.Status.Conditions.
+type LoadBalancerConfigConditionType string
+
+const (
+	// LoadBalancerConfigReady is added when the LoadBalancerConfig object has been successfully realized
+	LoadBalancerConfigReady LoadBalancerConfigConditionType = "Ready"
+	// LoadBalancerConfigFailure is added if any failure is encountered while realizing LoadBalancerConfig object
+	LoadBalancerConfigFailure LoadBalancerConfigConditionType = "Failure"
+	// LoadBalancerConfigIPPoolPressure condition status is set to True when IPPool is low on free IPs.
+	LoadBalancerConfigIPPoolPressure LoadBalancerConfigConditionType = "IPPoolPressure"
+)
+
+// LoadBalancerConfigCondition describes the state of a LoadBalancerConfig at a certain point
+type LoadBalancerConfigCondition struct {
+	// Type is the type of load balancer condition
+	// Can be Ready or Failure
+	Type LoadBalancerConfigConditionType `json:"type"`
+	// Status is the status of the condition
+	// Can be True, False, Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// Machine understandable string that gives the reason for the condition's last transition
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Provides a timestamp for when the LoadBalancerConfig object last transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" patchStrategy:"replace"`
+}
+
+type LoadBalancerConfigType string
+
+const (
+	// LoadBalancerConfigTypeHAProxy is the LoadBalancerConfigType for HAProxy.
+	LoadBalancerConfigTypeHAProxy LoadBalancerConfigType = "haproxy"
+
+	// LoadBalancerConfigTypeAvi is the LoadBalancerConfigType for Avi.
+	LoadBalancerConfigTypeAvi LoadBalancerConfigType = "avi"
+)
+
+// LoadBalancerConfigSpec defines the desired state of LoadBalancerConfig
+type LoadBalancerConfigSpec struct {
+	// Type describes type of load balancer. Supported value is haproxy
+	// +kubebuilder:validation:Enum=haproxy;avi
+	Type LoadBalancerConfigType `json:"type"`
+	// ProviderRef is reference to a load balancer provider object that provides the details for this type of load balancer
+	ProviderRef ProviderReference `json:"providerRef"`
+}
+
+// LoadBalancerConfigStatus defines the observed state of LoadBalancerConfig
+type LoadBalancerConfigStatus struct {
+	// Conditions is an array of current observed load balancer conditions
+	Conditions []LoadBalancerConfigCondition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=lbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.providerRef.kind"
+// +kubebuilder:printcolumn:name="Provider Name",type="string",JSONPath=".spec.providerRef.name",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// LoadBalancerConfig is the Schema for the LoadBalancerConfigs API
+type LoadBalancerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerConfigSpec   `json:"spec,omitempty"`
+	Status LoadBalancerConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerConfigList contains a list of LoadBalancerConfig
+type LoadBalancerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerConfig `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&LoadBalancerConfig{}, &LoadBalancerConfigList{})
+}
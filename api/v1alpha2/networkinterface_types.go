@@ -0,0 +1,217 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// NetworkInterfaceFinalizer allows the Controller to clean up resources associated
+	// with a NetworkInterface before removing it from the API Server.
+	NetworkInterfaceFinalizer = "networkinterface.netoperator.vmware.com"
+
+	// NetworkInterfaceClientManagedAnnotation annotations means the NetworkInterface is
+	// client managed and the Controller will not reconcile it. The value does not need
+	// to be truthy; the presence of the key is what disables reconciliation.
+	NetworkInterfaceClientManagedAnnotation = "networkinterface.netoperator.vmware.com/client-managed"
+)
+
+// IPConfig represents an IP configuration.
+type IPConfig struct {
+	// IP setting.
+	IP string `json:"ip"`
+	// IPFamily specifies the IP family (IPv4 vs IPv6) the IP belongs to.
+	IPFamily corev1.IPFamily `json:"ipFamily"`
+	// Gateway setting.
+	Gateway string `json:"gateway"`
+	// PrefixLength is the length of the network prefix of the IP address, ex. 24 for the IPv4
+	// subnet mask 255.255.255.0.
+	PrefixLength int32 `json:"prefixLength"`
+	// Routes is the list of static routes of the IP family of IP, in addition to the default
+	// route through Gateway. A network interface without Gateway and with Routes only routes
+	// traffic to the destinations of its routes.
+	// +optional
+	Routes []Route `json:"routes,omitempty"`
+	// Nameservers is the list of IP addresses of the DNS servers of the network interface, used
+	// before the DNS servers of its network.
+	// +optional
+	Nameservers []string `json:"nameservers,omitempty"`
+}
+
+// Route is a static route.
+type Route struct {
+	// Destination is the destination prefix of the route in CIDR notation, ex. 10.10.0.0/16.
+	Destination string `json:"destination"`
+	// NextHop is the IP address of the router of the route. It must be of the family of
+	// Destination. If unset, the destination is directly reachable on the link.
+	// +optional
+	NextHop string `json:"nextHop,omitempty"`
+	// Metric is the metric of the route. Routes with a lower metric are preferred.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Metric int32 `json:"metric,omitempty"`
+}
+
+// RequestedIPConfig is a request for a specific IP address.
+type RequestedIPConfig struct {
+	// IP is the requested IP address. It must be within the IPPools of the network.
+	IP string `json:"ip"`
+	// IPFamily specifies the IP family (IPv4 vs IPv6) the IP belongs to. Defaults to the family
+	// of IP.
+	// +optional
+	IPFamily corev1.IPFamily `json:"ipFamily,omitempty"`
+}
+
+type NetworkInterfaceConditionType string
+
+const (
+	// NetworkInterfaceReady is added when all network settings have been updated and the network
+	// interface is ready to be used.
+	NetworkInterfaceReady NetworkInterfaceConditionType = "Ready"
+	// NetworkInterfaceFailure is added when network provider plugin returns an error.
+	NetworkInterfaceFailure NetworkInterfaceConditionType = "Failure"
+)
+
+type NetworkInterfaceConditionReason string
+
+const (
+	// NetworkInterfaceFailureReasonCannotAllocIP indicates NetworkInterface is in failed state because an
+	// IPConfig cannot be allocated.
+	NetworkInterfaceFailureReasonCannotAllocIP NetworkInterfaceConditionReason = "CannotAllocIP"
+	// NetworkInterfaceFailureReasonCannotAllocPort indicates NetworkInterface is in failed state because
+	// port cannot be allocated for network interface on the network.
+	NetworkInterfaceFailureReasonCannotAllocPort NetworkInterfaceConditionReason = "CannotAllocPort"
+	// NetworkInterfaceFailureReasonRequestedIPUnavailable indicates NetworkInterface is in failed state
+	// because a requested IP address is not within the IPPools of the network or is allocated to
+	// another network interface.
+	NetworkInterfaceFailureReasonRequestedIPUnavailable NetworkInterfaceConditionReason = "RequestedIPUnavailable"
+)
+
+// NetworkInterfaceCondition describes the state of a NetworkInterface at a certain point.
+type NetworkInterfaceCondition struct {
+	// Type is the type of network interface condition.
+	Type NetworkInterfaceConditionType `json:"type"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Machine understandable string that gives the reason for condition's last transition.
+	Reason NetworkInterfaceConditionReason `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message string `json:"message,omitempty"`
+}
+
+// NetworkInterfaceStatus defines the observed state of NetworkInterface.
+// Once NetworkInterfaceReady condition is True, it should contain configuration to use to place
+// a VM/Pod/Container's nic on the specified network.
+type NetworkInterfaceStatus struct {
+	// Conditions is an array of current observed network interface conditions.
+	Conditions []NetworkInterfaceCondition `json:"conditions,omitempty"`
+	// IPConfigs is an array of IP configurations for the network interface.
+	IPConfigs []IPConfig `json:"ipConfigs,omitempty"`
+	// MacAddress setting for the network interface.
+	MacAddress string `json:"macAddress,omitempty"`
+	// ExternalID is a network provider specific identifier assigned to the network interface.
+	ExternalID string `json:"externalID,omitempty"`
+	// NetworkID is an network provider specific identifier for the network backing the network
+	// interface.
+	NetworkID string `json:"networkID,omitempty"`
+	// PortID is a network provider specific port identifier allocated for this network interface on
+	// the backing network. It is only valid on requested node and is set only if port allocation
+	// was requested.
+	PortID string `json:"portID,omitempty"`
+	// ConnectionID is a network provider specific port connection identifier allocated for this
+	// network interface on the backing network. It is only valid on requested node and is set
+	// only if port allocation was requested.
+	ConnectionID string `json:"connectionID,omitempty"`
+}
+
+type NetworkInterfaceType string
+
+const (
+	// NetworkInterfaceTypeVMXNet3 is for a VMXNET3 device.
+	NetworkInterfaceTypeVMXNet3 = NetworkInterfaceType("vmxnet3")
+	// NetworkInterfaceTypeE1000E is for an E1000E device.
+	NetworkInterfaceTypeE1000E = NetworkInterfaceType("e1000e")
+	// NetworkInterfaceTypeSRIOV is for an SR-IOV passthrough device.
+	NetworkInterfaceTypeSRIOV = NetworkInterfaceType("sriov")
+)
+
+// NetworkInterfacePortAllocation describes the settings for network interface port allocation request.
+type NetworkInterfacePortAllocation struct {
+	// NodeName is the node where port must be allocated for this network interface.
+	NodeName string `json:"nodeName"`
+}
+
+// NetworkInterfaceSpec defines the desired state of NetworkInterface.
+type NetworkInterfaceSpec struct {
+	// NetworkName refers to a NetworkObject in the same namespace.
+	NetworkName string `json:"networkName,omitempty"`
+	// Type is the type of NetworkInterface. Supported values are vmxnet3, e1000e and sriov.
+	// Defaults to vmxnet3.
+	// +kubebuilder:default:=vmxnet3
+	Type NetworkInterfaceType `json:"type,omitempty"`
+	// ProviderRef is a reference to a provider specific network interface object
+	// that specifies the network interface configuration.
+	// If unset, default configuration is assumed.
+	ProviderRef *ProviderReference `json:"providerRef,omitempty"`
+	// PortAllocation is a request to allocate a port for this network interface on the backing network.
+	// This feature is currently supported only if backing network type is NetworkTypeVDS. In all other
+	// cases this field is ignored. Typically this is done implicitly by vCenter Server at the time
+	// of attaching a network interface to a network and should be left unset. This is used primarily when
+	// attachment of network interface to the network is done without vCenter Server's knowledge.
+	PortAllocation *NetworkInterfacePortAllocation `json:"portAllocation,omitempty"`
+	// RequestedIPConfigs are the IP addresses requested for this network interface, at most one per
+	// IP family. The addresses are allocated from the IPPools of the network instead of free ones,
+	// which keeps the address of a network interface stable when it is recreated. If unset, any free
+	// address is allocated.
+	// +optional
+	RequestedIPConfigs []RequestedIPConfig `json:"requestedIPConfigs,omitempty"`
+	// DefaultRoute is false to not route traffic through the gateway of the network. The IPConfigs
+	// of the network interface then have no Gateway and only route traffic to the destinations of
+	// their Routes, which keeps the default route of a VM with several network interfaces on one of
+	// them. Defaults to true.
+	// +optional
+	DefaultRoute *bool `json:"defaultRoute,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=netif,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network",type="string",JSONPath=".spec.networkName"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",priority=1
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.ipConfigs[0].ip"
+// +kubebuilder:printcolumn:name="MAC",type="string",JSONPath=".status.macAddress"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// NetworkInterface is the Schema for the networkinterfaces API.
+// A NetworkInterface represents a user's request for network configuration to use to place a
+// VM/Pod/Container's nic on a specified network.
+type NetworkInterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkInterfaceSpec   `json:"spec,omitempty"`
+	Status NetworkInterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkInterfaceList contains a list of NetworkInterface
+type NetworkInterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkInterface `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&NetworkInterface{}, &NetworkInterfaceList{})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NSXTNetworkConditionType string
+
+const (
+	// NSXTNetworkReady is added when the segment and the Tier-1 router of the network have been
+	// verified to exist and the network is ready for use.
+	NSXTNetworkReady NSXTNetworkConditionType = "Ready"
+	// NSXTNetworkSegmentFailure is added when the segment specified by SegmentPath either doesn't
+	// exist, or there was an error in communicating with NSX-T Manager.
+	NSXTNetworkSegmentFailure NSXTNetworkConditionType = "SegmentFailure"
+	// NSXTNetworkTier1RouterFailure is added when the Tier-1 router specified by Tier1Router either
+	// doesn't exist, or the segment is not connected to it.
+	NSXTNetworkTier1RouterFailure NSXTNetworkConditionType = "Tier1RouterFailure"
+)
+
+// NSXTNetworkCondition describes the state of a NSXTNetwork at a certain point.
+type NSXTNetworkCondition struct {
+	// Type is the type of NSXTNetwork condition.
+	Type NSXTNetworkConditionType `json:"type"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Machine understandable string that gives the reason for condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message string `json:"message,omitempty"`
+	// Provides a timestamp for when the NSXTNetwork object last transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" patchStrategy:"replace"`
+}
+
+// NSXTNetworkSpec defines the desired state of NSXTNetwork.
+type NSXTNetworkSpec struct {
+	// Tier1Router is the policy path of the NSX-T Tier-1 router the segment is connected to,
+	// ex. /infra/tier-1s/t1-default.
+	Tier1Router string `json:"tier1Router"`
+
+	// SegmentPath is the policy path of an existing NSX-T segment, ex. /infra/segments/web.
+	SegmentPath string `json:"segmentPath"`
+
+	// SubnetCIDRs are the subnets of the segment in CIDR notation, ex. 192.168.10.0/24.
+	// +optional
+	SubnetCIDRs []string `json:"subnetCIDRs,omitempty"`
+
+	// SNATIP is the IP address used by the Tier-1 router to source NAT traffic leaving the
+	// network. If empty, traffic leaving the network is not translated.
+	// +optional
+	SNATIP string `json:"snatIP,omitempty"`
+}
+
+// NSXTNetworkStatus defines the observed state of NSXTNetwork.
+type NSXTNetworkStatus struct {
+	// Conditions is an array of current observed NSX-T network conditions.
+	Conditions []NSXTNetworkCondition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=nsxtnet,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Tier-1 Router",type="string",JSONPath=".spec.tier1Router"
+// +kubebuilder:printcolumn:name="Segment",type="string",JSONPath=".spec.segmentPath"
+// +kubebuilder:printcolumn:name="SNAT IP",type="string",JSONPath=".spec.snatIP",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// NSXTNetwork represents schema for a network backed by a NSX-T segment connected to a Tier-1 router.
+type NSXTNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NSXTNetworkSpec   `json:"spec,omitempty"`
+	Status NSXTNetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NSXTNetworkList contains a list of NSXTNetwork
+type NSXTNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NSXTNetwork `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&NSXTNetwork{}, &NSXTNetworkList{})
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SRIOVNetworkInterfaceSpec defines the desired state of SRIOVNetworkInterface.
+type SRIOVNetworkInterfaceSpec struct {
+	// PhysicalFunction is the PCI address of the physical function backing the network interface,
+	// in the form domain:bus:device.function, ex. 0000:3b:00.0. If unset, any physical function
+	// of the host with a free virtual function is used.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`
+	PhysicalFunction string `json:"physicalFunction,omitempty"`
+	// VirtualFunctionCount is a hint of the number of virtual functions the physical function should
+	// expose. It is used when placing the network interface and is not a guarantee.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	VirtualFunctionCount int32 `json:"virtualFunctionCount,omitempty"`
+	// GuestOSMTU is the MTU the guest OS configures on the network interface. If unset, the guest OS
+	// uses the MTU of the physical function.
+	// +optional
+	// +kubebuilder:validation:Minimum=68
+	// +kubebuilder:validation:Maximum=9000
+	GuestOSMTU int32 `json:"guestOSMTU,omitempty"`
+}
+
+// SRIOVNetworkInterfaceStatus is unused. SRIOVNetworkInterface is a configuration only resource.
+type SRIOVNetworkInterfaceStatus struct {
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=sriovni,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Physical Function",type="string",JSONPath=".spec.physicalFunction"
+// +kubebuilder:printcolumn:name="Guest OS MTU",type="integer",JSONPath=".spec.guestOSMTU"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// SRIOVNetworkInterface is the Schema for the sriovnetworkinterfaces API.
+// It represents configuration of a vSphere SR-IOV passthrough network interface card.
+type SRIOVNetworkInterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SRIOVNetworkInterfaceSpec   `json:"spec,omitempty"`
+	Status SRIOVNetworkInterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SRIOVNetworkInterfaceList contains a list of SRIOVNetworkInterface
+type SRIOVNetworkInterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SRIOVNetworkInterface `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&SRIOVNetworkInterface{}, &SRIOVNetworkInterfaceList{})
+}
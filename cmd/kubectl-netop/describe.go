@@ -0,0 +1,224 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+)
+
+func newDescribeCommand(o *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Show the details of an object and the objects it references",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "network NAME",
+		Short: "Show a network together with its provider and IP pools",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, namespace, err := o.client()
+			if err != nil {
+				return err
+			}
+			return describeNetwork(cmd.OutOrStdout(), c, namespace, args[0])
+		},
+	})
+	return cmd
+}
+
+// describeNetwork writes the network with the given name and the provider it
+// references. The IP pools of a VSphereDistributedNetwork provider are written
+// as well.
+func describeNetwork(out io.Writer, c clientset.Interface, namespace, name string) error {
+	network, err := c.NetoperatorV1alpha1().Networks(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	w := newTabWriter(out)
+	ref := network.Spec.ProviderRef
+	fmt.Fprintf(w, "Name:\t%s\n", network.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", network.Namespace)
+	fmt.Fprintf(w, "Type:\t%s\n", network.Spec.Type)
+	fmt.Fprintf(w, "Provider:\t%s\n", providerName(ref.Kind, ref.APIGroup, ref.Namespace, ref.Name))
+	fmt.Fprintf(w, "DNS:\t%s\n", joinOrNone(network.Spec.DNS))
+	fmt.Fprintf(w, "DNS Search Domains:\t%s\n", joinOrNone(network.Spec.DNSSearchDomains))
+	fmt.Fprintf(w, "NTP:\t%s\n", joinOrNone(network.Spec.NTP))
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if ref.APIGroup != v1alpha1.GroupName {
+		fmt.Fprintf(out, "\nProvider kind %s.%s is not supported\n", ref.Kind, ref.APIGroup)
+		return nil
+	}
+	switch ref.Kind {
+	case "NSXTNetwork":
+		return describeNSXTNetwork(out, c, ref.Name)
+	case "VSphereDistributedNetwork":
+		return describeVSphereDistributedNetwork(out, c, ref.Name)
+	default:
+		fmt.Fprintf(out, "\nProvider kind %s.%s is not supported\n", ref.Kind, ref.APIGroup)
+		return nil
+	}
+}
+
+// describeNSXTNetwork writes the NSXTNetwork with the given name.
+func describeNSXTNetwork(out io.Writer, c clientset.Interface, name string) error {
+	nsxt, err := c.NetoperatorV1alpha1().NSXTNetworks().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	w := newTabWriter(out)
+	fmt.Fprintf(w, "\nNSXTNetwork:\t%s\n", nsxt.Name)
+	fmt.Fprintf(w, "  Tier-1 Router:\t%s\n", nsxt.Spec.Tier1Router)
+	fmt.Fprintf(w, "  Segment Path:\t%s\n", nsxt.Spec.SegmentPath)
+	fmt.Fprintf(w, "  Subnet CIDRs:\t%s\n", joinOrNone(nsxt.Spec.SubnetCIDRs))
+	fmt.Fprintf(w, "  SNAT IP:\t%s\n", valueOrNone(nsxt.Spec.SNATIP))
+	conditions := make([][4]string, 0, len(nsxt.Status.Conditions))
+	for _, cond := range nsxt.Status.Conditions {
+		conditions = append(conditions, [4]string{string(cond.Type), string(cond.Status), cond.Reason, cond.Message})
+	}
+	writeConditions(w, conditions)
+	return w.Flush()
+}
+
+// describeVSphereDistributedNetwork writes the VSphereDistributedNetwork with
+// the given name and its IP pools.
+func describeVSphereDistributedNetwork(out io.Writer, c clientset.Interface, name string) error {
+	vdn, err := c.NetoperatorV1alpha1().VSphereDistributedNetworks().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	w := newTabWriter(out)
+	fmt.Fprintf(w, "\nVSphereDistributedNetwork:\t%s\n", vdn.Name)
+	fmt.Fprintf(w, "  Port Group ID:\t%s\n", valueOrNone(vdn.Spec.PortGroupID))
+	if c := vdn.Spec.PortGroupCreation; c != nil {
+		fmt.Fprintf(w, "  Created On Distributed Switch:\t%s\n", c.DistributedSwitchID)
+	}
+	fmt.Fprintf(w, "  IP Assignment Mode:\t%s\n", valueOrNone(string(vdn.Spec.IPAssignmentMode)))
+	fmt.Fprintf(w, "  Gateway:\t%s\n", valueOrNone(vdn.Spec.Gateway))
+	fmt.Fprintf(w, "  Subnet Mask:\t%s\n", valueOrNone(vdn.Spec.SubnetMask))
+	routes := make([]string, 0, len(vdn.Spec.Routes))
+	for _, r := range vdn.Spec.Routes {
+		routes = append(routes, routeString(r))
+	}
+	fmt.Fprintf(w, "  Routes:\t%s\n", joinOrNone(routes))
+	if v6 := vdn.Spec.IPv6; v6 != nil {
+		fmt.Fprintf(w, "  IPv6 Assignment Mode:\t%s\n", valueOrNone(string(v6.IPAssignmentMode)))
+		fmt.Fprintf(w, "  IPv6 Gateway:\t%s\n", valueOrNone(v6.Gateway))
+		fmt.Fprintf(w, "  IPv6 Prefix Length:\t%d\n", v6.PrefixLength)
+	}
+	if vdn.Spec.VLANID != nil {
+		fmt.Fprintf(w, "  VLAN ID:\t%d\n", *vdn.Spec.VLANID)
+	}
+	if len(vdn.Spec.VLANTrunkRanges) > 0 {
+		trunk := make([]string, 0, len(vdn.Spec.VLANTrunkRanges))
+		for _, r := range vdn.Spec.VLANTrunkRanges {
+			trunk = append(trunk, fmt.Sprintf("%d-%d", r.Start, r.End))
+		}
+		fmt.Fprintf(w, "  VLAN Trunk Ranges:\t%s\n", strings.Join(trunk, ", "))
+	}
+	if vdn.Spec.MTU != 0 {
+		fmt.Fprintf(w, "  MTU:\t%d\n", vdn.Spec.MTU)
+	}
+	if sec := vdn.Spec.Security; sec != nil {
+		fmt.Fprintf(w, "  Allow Promiscuous:\t%s\n", boolOrNone(sec.AllowPromiscuous))
+		fmt.Fprintf(w, "  MAC Changes:\t%s\n", boolOrNone(sec.MACChanges))
+		fmt.Fprintf(w, "  Forged Transmits:\t%s\n", boolOrNone(sec.ForgedTransmits))
+	}
+	conditions := make([][4]string, 0, len(vdn.Status.Conditions))
+	for _, cond := range vdn.Status.Conditions {
+		conditions = append(conditions, [4]string{string(cond.Type), string(cond.Status), cond.Reason, cond.Message})
+	}
+	writeConditions(w, conditions)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	poolRefs := vdn.Spec.IPPools
+	if v6 := vdn.Spec.IPv6; v6 != nil {
+		poolRefs = append(poolRefs[:len(poolRefs):len(poolRefs)], v6.IPPools...)
+	}
+	if len(poolRefs) == 0 {
+		fmt.Fprintf(out, "\nIP Pools: <none>\n")
+		return nil
+	}
+	fmt.Fprintf(out, "\nIP Pools:\n")
+	w = newTabWriter(out)
+	fmt.Fprintf(w, "  %s\n", poolHeader)
+	for _, poolRef := range poolRefs {
+		pool, err := c.NetoperatorV1alpha1().IPPools().Get(poolRef.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			fmt.Fprintf(w, "  %s\t<not found>\n", poolRef.Name)
+		case err != nil:
+			return err
+		default:
+			fmt.Fprintf(w, "  %s\n", poolRow(pool))
+		}
+	}
+	return w.Flush()
+}
+
+// boolOrNone returns the value of b, or <none> if b is nil.
+func boolOrNone(b *bool) string {
+	if b == nil {
+		return "<none>"
+	}
+	return strconv.FormatBool(*b)
+}
+
+// routeString returns the route in the form of the ip route command, ex.
+// 10.10.0.0/16 via 192.168.1.1 metric 100.
+func routeString(r v1alpha1.Route) string {
+	s := r.Destination
+	if r.NextHop != "" {
+		s += " via " + r.NextHop
+	}
+	if r.Metric != 0 {
+		s += fmt.Sprintf(" metric %d", r.Metric)
+	}
+	return s
+}
+
+// writeConditions writes the type, status, reason and message of each
+// condition of a provider.
+func writeConditions(w io.Writer, conditions [][4]string) {
+	if len(conditions) == 0 {
+		fmt.Fprintf(w, "  Conditions:\t<none>\n")
+		return
+	}
+	fmt.Fprintf(w, "  Conditions:\n")
+	fmt.Fprintf(w, "    TYPE\tSTATUS\tREASON\tMESSAGE\n")
+	for _, c := range conditions {
+		fmt.Fprintf(w, "    %s\t%s\t%s\t%s\n", c[0], c[1], c[2], c[3])
+	}
+}
+
+// providerName returns the reference to a provider in the form
+// KIND.GROUP/[NAMESPACE/]NAME.
+func providerName(kind, group, namespace, name string) string {
+	if namespace != "" {
+		name = namespace + "/" + name
+	}
+	return kind + "." + group + "/" + name
+}
+
+func joinOrNone(s []string) string {
+	if len(s) == 0 {
+		return "<none>"
+	}
+	return strings.Join(s, ", ")
+}
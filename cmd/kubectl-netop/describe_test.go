@@ -0,0 +1,155 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/fake"
+)
+
+func newNetwork(kind, name string, spec v1alpha1.NetworkSpec) *v1alpha1.Network {
+	spec.ProviderRef = v1alpha1.NetworkProviderReference{APIGroup: v1alpha1.GroupName, Kind: kind, Name: name}
+	return &v1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: "ns"},
+		Spec:       spec,
+	}
+}
+
+func TestDescribeNetwork(t *testing.T) {
+	tests := []struct {
+		name string
+		objs []runtime.Object
+		want string
+	}{
+		{
+			name: "VSphereDistributedNetwork",
+			objs: []runtime.Object{
+				newNetwork("VSphereDistributedNetwork", "vdn", v1alpha1.NetworkSpec{
+					Type: v1alpha1.NetworkTypeVDS,
+					DNS:  []string{"192.168.1.2", "192.168.1.3"},
+				}),
+				&v1alpha1.VSphereDistributedNetwork{
+					ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+					Spec: v1alpha1.VSphereDistributedNetworkSpec{
+						PortGroupID:      "dvportgroup-1",
+						IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+						IPPools:          []v1alpha1.IPPoolReference{{Name: "pool"}, {Name: "missing"}},
+						Gateway:          "192.168.1.1",
+						SubnetMask:       "255.255.255.0",
+						Routes:           []v1alpha1.Route{{Destination: "10.10.0.0/16", NextHop: "192.168.1.254", Metric: 100}},
+					},
+					Status: v1alpha1.VSphereDistributedNetworkStatus{
+						Conditions: []v1alpha1.VSphereDistributedNetworkCondition{{
+							Type:   v1alpha1.VsphereDistributedNetworkIPPoolPressure,
+							Status: corev1.ConditionFalse,
+							Reason: "Available",
+						}},
+					},
+				},
+				&v1alpha1.IPPool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+					Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+					Status:     v1alpha1.IPPoolStatus{AllocatedCount: 5, FreeCount: 5},
+				},
+			},
+			want: "" +
+				"Name:                 network\n" +
+				"Namespace:            ns\n" +
+				"Type:                 vsphere-distributed\n" +
+				"Provider:             VSphereDistributedNetwork.netoperator.vmware.com/vdn\n" +
+				"DNS:                  192.168.1.2, 192.168.1.3\n" +
+				"DNS Search Domains:   <none>\n" +
+				"NTP:                  <none>\n" +
+				"\n" +
+				"VSphereDistributedNetwork:   vdn\n" +
+				"  Port Group ID:             dvportgroup-1\n" +
+				"  IP Assignment Mode:        staticpool\n" +
+				"  Gateway:                   192.168.1.1\n" +
+				"  Subnet Mask:               255.255.255.0\n" +
+				"  Routes:                    10.10.0.0/16 via 192.168.1.254 metric 100\n" +
+				"  Conditions:\n" +
+				"    TYPE             STATUS   REASON      MESSAGE\n" +
+				"    IPPoolPressure   False    Available   \n" +
+				"\n" +
+				"IP Pools:\n" +
+				"  NAME      START          SIZE   ALLOCATED   HELD   FREE   UTILIZATION\n" +
+				"  pool      192.168.1.10   10     5           0      5      50%\n" +
+				"  missing   <not found>\n",
+		},
+		{
+			name: "NSXTNetwork",
+			objs: []runtime.Object{
+				newNetwork("NSXTNetwork", "nsxt", v1alpha1.NetworkSpec{Type: v1alpha1.NetworkTypeNSXT}),
+				&v1alpha1.NSXTNetwork{
+					ObjectMeta: metav1.ObjectMeta{Name: "nsxt"},
+					Spec: v1alpha1.NSXTNetworkSpec{
+						Tier1Router: "/infra/tier-1s/t1",
+						SegmentPath: "/infra/segments/segment",
+						SubnetCIDRs: []string{"10.0.0.0/24"},
+					},
+				},
+			},
+			want: "" +
+				"Name:                 network\n" +
+				"Namespace:            ns\n" +
+				"Type:                 nsx-t\n" +
+				"Provider:             NSXTNetwork.netoperator.vmware.com/nsxt\n" +
+				"DNS:                  <none>\n" +
+				"DNS Search Domains:   <none>\n" +
+				"NTP:                  <none>\n" +
+				"\n" +
+				"NSXTNetwork:       nsxt\n" +
+				"  Tier-1 Router:   /infra/tier-1s/t1\n" +
+				"  Segment Path:    /infra/segments/segment\n" +
+				"  Subnet CIDRs:    10.0.0.0/24\n" +
+				"  SNAT IP:         <none>\n" +
+				"  Conditions:      <none>\n",
+		},
+		{
+			name: "unsupported provider",
+			objs: []runtime.Object{
+				newNetwork("Other", "other", v1alpha1.NetworkSpec{}),
+			},
+			want: "" +
+				"Name:                 network\n" +
+				"Namespace:            ns\n" +
+				"Type:                 \n" +
+				"Provider:             Other.netoperator.vmware.com/other\n" +
+				"DNS:                  <none>\n" +
+				"DNS Search Domains:   <none>\n" +
+				"NTP:                  <none>\n" +
+				"\n" +
+				"Provider kind Other.netoperator.vmware.com is not supported\n",
+		},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := describeNetwork(&out, fake.NewSimpleClientset(tt.objs...), "ns", "network"); err != nil {
+			t.Errorf("%s: describeNetwork() = %v", tt.name, err)
+			continue
+		}
+		if got := out.String(); got != tt.want {
+			t.Errorf("%s: describeNetwork() wrote\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeNetworkMissingProvider(t *testing.T) {
+	c := fake.NewSimpleClientset(newNetwork("VSphereDistributedNetwork", "vdn", v1alpha1.NetworkSpec{}))
+	var out bytes.Buffer
+	if err := describeNetwork(&out, c, "ns", "network"); !apierrors.IsNotFound(err) {
+		t.Errorf("describeNetwork() of a network with a missing provider = %v, want NotFound", err)
+	}
+	if err := describeNetwork(&out, c, "other", "network"); !apierrors.IsNotFound(err) {
+		t.Errorf("describeNetwork() of a missing network = %v, want NotFound", err)
+	}
+}
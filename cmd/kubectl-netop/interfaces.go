@@ -0,0 +1,92 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+)
+
+func newInterfacesCommand(o *options) *cobra.Command {
+	var network string
+	cmd := &cobra.Command{
+		Use:   "interfaces",
+		Short: "List network interfaces with their addresses and conditions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, namespace, err := o.client()
+			if err != nil {
+				return err
+			}
+			return listInterfaces(cmd.OutOrStdout(), c, namespace, network)
+		},
+	}
+	cmd.Flags().StringVar(&network, "network", "", "Only list the network interfaces attached to this network")
+	return cmd
+}
+
+// listInterfaces writes the network interfaces in the given namespace. If
+// network is not empty, only the interfaces attached to that network are
+// written.
+func listInterfaces(out io.Writer, c clientset.Interface, namespace, network string) error {
+	list, err := c.NetoperatorV1alpha1().NetworkInterfaces(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var interfaces []v1alpha1.NetworkInterface
+	for _, ni := range list.Items {
+		if network == "" || ni.Spec.NetworkName == network {
+			interfaces = append(interfaces, ni)
+		}
+	}
+	sort.Slice(interfaces, func(i, j int) bool { return interfaces[i].Name < interfaces[j].Name })
+
+	w := newTabWriter(out)
+	fmt.Fprintln(w, "NAME\tNETWORK\tIP\tMAC\tREADY\tFAILURE")
+	for _, ni := range interfaces {
+		ips := make([]string, 0, len(ni.Status.IPConfigs))
+		for _, c := range ni.Status.IPConfigs {
+			ips = append(ips, c.IP)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			ni.Name,
+			valueOrNone(ni.Spec.NetworkName),
+			valueOrNone(strings.Join(ips, ",")),
+			valueOrNone(ni.Status.MacAddress),
+			interfaceCondition(&ni, v1alpha1.NetworkInterfaceReady),
+			interfaceCondition(&ni, v1alpha1.NetworkInterfaceFailure))
+	}
+	return w.Flush()
+}
+
+// interfaceCondition returns the status of the condition of the given type,
+// followed by its reason if it has one.
+func interfaceCondition(ni *v1alpha1.NetworkInterface, t v1alpha1.NetworkInterfaceConditionType) string {
+	for _, c := range ni.Status.Conditions {
+		if c.Type != t {
+			continue
+		}
+		if c.Reason != "" {
+			return fmt.Sprintf("%s (%s)", c.Status, c.Reason)
+		}
+		return string(c.Status)
+	}
+	return string(corev1.ConditionUnknown)
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
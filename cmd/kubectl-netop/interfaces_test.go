@@ -0,0 +1,91 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/fake"
+)
+
+func TestListInterfaces(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm-2", Namespace: "ns"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+			Status: v1alpha1.NetworkInterfaceStatus{
+				Conditions: []v1alpha1.NetworkInterfaceCondition{{
+					Type:   v1alpha1.NetworkInterfaceFailure,
+					Status: corev1.ConditionTrue,
+					Reason: v1alpha1.NetworkInterfaceFailureReasonCannotAllocIP,
+				}},
+			},
+		},
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm-1", Namespace: "ns"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+			Status: v1alpha1.NetworkInterfaceStatus{
+				IPConfigs: []v1alpha1.IPConfig{
+					{IP: "192.168.1.10", IPFamily: corev1.IPv4Protocol},
+					{IP: "fd00::10", IPFamily: corev1.IPv6Protocol},
+				},
+				MacAddress: "00:50:56:00:00:01",
+				Conditions: []v1alpha1.NetworkInterfaceCondition{{
+					Type:   v1alpha1.NetworkInterfaceReady,
+					Status: corev1.ConditionTrue,
+				}},
+			},
+		},
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm-3", Namespace: "ns"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "other"},
+		},
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm-4", Namespace: "other"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+		},
+	)
+
+	tests := []struct {
+		name    string
+		network string
+		want    string
+	}{
+		{
+			name: "all networks",
+			want: "" +
+				"NAME   NETWORK   IP                      MAC                 READY     FAILURE\n" +
+				"vm-1   network   192.168.1.10,fd00::10   00:50:56:00:00:01   True      Unknown\n" +
+				"vm-2   network   <none>                  <none>              Unknown   True (CannotAllocIP)\n" +
+				"vm-3   other     <none>                  <none>              Unknown   Unknown\n",
+		},
+		{
+			name:    "network",
+			network: "other",
+			want: "" +
+				"NAME   NETWORK   IP       MAC      READY     FAILURE\n" +
+				"vm-3   other     <none>   <none>   Unknown   Unknown\n",
+		},
+		{
+			name:    "no interfaces",
+			network: "missing",
+			want:    "NAME   NETWORK   IP   MAC   READY   FAILURE\n",
+		},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := listInterfaces(&out, c, "ns", tt.network); err != nil {
+			t.Errorf("%s: listInterfaces() = %v", tt.name, err)
+			continue
+		}
+		if got := out.String(); got != tt.want {
+			t.Errorf("%s: listInterfaces() wrote\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
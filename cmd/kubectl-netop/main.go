@@ -0,0 +1,17 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Command kubectl-netop is a kubectl plugin for inspecting the objects of the
+// netoperator.vmware.com API group. Install it anywhere on the PATH and run it
+// as "kubectl netop".
+package main
+
+import (
+	"os"
+)
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+)
+
+// poolHeader is the header of the columns written by poolRow.
+const poolHeader = "NAME\tSTART\tSIZE\tALLOCATED\tHELD\tFREE\tUTILIZATION"
+
+func newPoolsCommand(o *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pools",
+		Short: "List IP pools and their utilization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, _, err := o.client()
+			if err != nil {
+				return err
+			}
+			return listPools(cmd.OutOrStdout(), c)
+		},
+	}
+}
+
+// listPools writes the utilization of all IP pools.
+func listPools(out io.Writer, c clientset.Interface) error {
+	list, err := c.NetoperatorV1alpha1().IPPools().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pools := list.Items
+	sort.Slice(pools, func(i, j int) bool { return pools[i].Name < pools[j].Name })
+
+	w := newTabWriter(out)
+	fmt.Fprintln(w, poolHeader)
+	for i := range pools {
+		fmt.Fprintln(w, poolRow(&pools[i]))
+	}
+	return w.Flush()
+}
+
+// poolRow returns the columns described by poolHeader for the given pool.
+func poolRow(pool *v1alpha1.IPPool) string {
+	start := ""
+	if ranges, err := pool.Spec.AddressRanges(); err == nil {
+		start = ranges[0].Start.String()
+	}
+	size := pool.Spec.Size()
+	allocated := pool.Status.AllocatedCount
+	utilization := "0%"
+	if size > 0 {
+		utilization = fmt.Sprintf("%d%%", allocated*100/size)
+	}
+	return fmt.Sprintf("%s\t%s\t%d\t%d\t%d\t%d\t%s",
+		pool.Name, start, size, allocated, pool.Status.HeldCount, pool.Status.FreeCount, utilization)
+}
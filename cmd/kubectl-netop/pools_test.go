@@ -0,0 +1,46 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/fake"
+)
+
+func TestListPools(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool-b"},
+			Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 3},
+			Status:     v1alpha1.IPPoolStatus{AllocatedCount: 2, FreeCount: 1},
+		},
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool-a"},
+			Spec:       v1alpha1.IPPoolSpec{CIDRs: []string{"10.0.0.0/24"}},
+			Status:     v1alpha1.IPPoolStatus{AllocatedCount: 64, HeldCount: 2, FreeCount: 188},
+		},
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool-c"},
+		},
+	)
+	var out bytes.Buffer
+	if err := listPools(&out, c); err != nil {
+		t.Fatalf("listPools() = %v", err)
+	}
+	// The network and broadcast addresses of a CIDR are not in the pool, the
+	// utilization is rounded down, and an empty pool is 0% utilized.
+	want := "" +
+		"NAME     START          SIZE   ALLOCATED   HELD   FREE   UTILIZATION\n" +
+		"pool-a   10.0.0.1       254    64          2      188    25%\n" +
+		"pool-b   192.168.1.10   3      2           0      1      66%\n" +
+		"pool-c                  0      0           0      0      0%\n"
+	if got := out.String(); got != want {
+		t.Errorf("listPools() wrote\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+)
+
+// options are the flags shared by all commands.
+type options struct {
+	kubeconfig string
+	context    string
+	namespace  string
+}
+
+// clientConfig returns the client config selected by the flags, following
+// the same loading rules as kubectl.
+func (o *options) clientConfig() clientcmd.ClientConfig {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = o.kubeconfig
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: o.context,
+		Context:        clientcmdapi.Context{Namespace: o.namespace},
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+}
+
+// client returns a typed client for the cluster selected by the flags and the
+// namespace to operate in.
+func (o *options) client() (clientset.Interface, string, error) {
+	cfg := o.clientConfig()
+	namespace, _, err := cfg.Namespace()
+	if err != nil {
+		return nil, "", err
+	}
+	restConfig, err := cfg.ClientConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	c, err := clientset.NewForConfig(restConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	return c, namespace, nil
+}
+
+func newRootCommand() *cobra.Command {
+	o := &options{}
+	cmd := &cobra.Command{
+		Use:          "kubectl-netop",
+		Short:        "Inspect networks, network interfaces and IP pools",
+		SilenceUsage: true,
+	}
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&o.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file to use")
+	flags.StringVar(&o.context, "context", "", "The name of the kubeconfig context to use")
+	flags.StringVarP(&o.namespace, "namespace", "n", "", "The namespace of the networks and network interfaces")
+
+	cmd.AddCommand(
+		newDescribeCommand(o),
+		newInterfacesCommand(o),
+		newPoolsCommand(o),
+	)
+	return cmd
+}
+
+// newTabWriter returns a writer that aligns tab separated columns the way
+// kubectl does.
+func newTabWriter(out io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+}
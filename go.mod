@@ -3,10 +3,15 @@ module github.com/vmware-tanzu/net-operator-api
 go 1.13
 
 require (
+	github.com/google/gofuzz v1.0.0
+	github.com/spf13/cobra v0.0.5
+	github.com/vmware/govmomi v0.23.0
 	golang.org/x/oauth2 v0.0.0-20200902213428-5d25da1a8d43 // indirect
 	golang.org/x/time v0.0.0-20200630173020-3af7569d3a1e // indirect
 	k8s.io/api v0.17.4
 	k8s.io/apimachinery v0.17.4
 	k8s.io/client-go v0.17.2
 	k8s.io/utils v0.0.0-20200821003339-5e75c0163111 // indirect
+	sigs.k8s.io/controller-runtime v0.5.2
+	sigs.k8s.io/yaml v1.1.0
 )
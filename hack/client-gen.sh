@@ -7,7 +7,7 @@ set -o nounset
 TOOLS_PATH=hack/tools/bin
 PKG=github.com/vmware-tanzu/net-operator-api
 
-VERSION=v1alpha1
+VERSIONS=(v1alpha1 v1alpha2)
 
 CLIENTGEN_PATH=$PKG/pkg/client/clientset_generated
 LISTERGEN_PATH=$PKG/pkg/client/listers_generated
@@ -15,12 +15,35 @@ INFORMERGEN_PATH=$PKG/pkg/client/informers_generated
 CLIENTSET_NAME=clientset
 HEADER_FILE=hack/boilerplate/boilerplate.go.txt
 
-$TOOLS_PATH/client-gen --go-header-file $HEADER_FILE --input-base $PKG/api --input /$VERSION \
-    --clientset-path $CLIENTGEN_PATH --clientset-name $CLIENTSET_NAME
-
-mv pkg/client/clientset_generated/clientset/typed/v1alpha1/_client.go pkg/client/clientset_generated/clientset/typed/v1alpha1/client.go
-
-$TOOLS_PATH/lister-gen --input-dirs $PKG/api/$VERSION --go-header-file $HEADER_FILE --output-package $LISTERGEN_PATH
-
-$TOOLS_PATH/informer-gen --single-directory --input-dirs $PKG/api/$VERSION --go-header-file $HEADER_FILE \
-    --output-package $INFORMERGEN_PATH --listers-package $LISTERGEN_PATH --versioned-clientset-package $CLIENTGEN_PATH/$CLIENTSET_NAME
+# The generators write into a GOPATH style tree rooted at OUTPUT_BASE, which is
+# copied over pkg/client once all of them have run.
+OUTPUT_BASE=$(mktemp -d)
+trap 'rm -rf "$OUTPUT_BASE"' EXIT
+
+INPUTS=""
+INPUT_DIRS=""
+for VERSION in "${VERSIONS[@]}"; do
+    INPUTS=${INPUTS:+$INPUTS,}/$VERSION
+    INPUT_DIRS=${INPUT_DIRS:+$INPUT_DIRS,}$PKG/api/$VERSION
+done
+
+$TOOLS_PATH/client-gen --go-header-file $HEADER_FILE --input-base $PKG/api --input "$INPUTS" \
+    --clientset-path $CLIENTGEN_PATH --clientset-name $CLIENTSET_NAME --output-base "$OUTPUT_BASE"
+
+$TOOLS_PATH/lister-gen --input-dirs "$INPUT_DIRS" --go-header-file $HEADER_FILE --output-package $LISTERGEN_PATH \
+    --output-base "$OUTPUT_BASE"
+
+$TOOLS_PATH/informer-gen --single-directory --input-dirs "$INPUT_DIRS" --go-header-file $HEADER_FILE \
+    --output-package $INFORMERGEN_PATH --listers-package $LISTERGEN_PATH \
+    --versioned-clientset-package $CLIENTGEN_PATH/$CLIENTSET_NAME --output-base "$OUTPUT_BASE"
+
+# The API packages are not nested in a group directory, so the generated group
+# client files are missing the group part of their names.
+for VERSION in "${VERSIONS[@]}"; do
+    TYPED_PATH=$OUTPUT_BASE/$CLIENTGEN_PATH/$CLIENTSET_NAME/typed/$VERSION
+    mv "$TYPED_PATH/_client.go" "$TYPED_PATH/client.go"
+    mv "$TYPED_PATH/fake/fake__client.go" "$TYPED_PATH/fake/fake_client.go"
+done
+
+rm -rf pkg/client
+cp -R "$OUTPUT_BASE/$PKG/pkg/client" pkg/client
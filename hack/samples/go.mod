@@ -2,7 +2,7 @@ module github.com/vmware-tanzu/net-operator-api/hack/samples
 
 go 1.13
 
-// The generated client is not part of the release, so point to the local version that includes the generated code
+// Point to the local version of the API so the samples build against the current generated code
 // Note also that net-operator-api is not specified in require the samples automatically pull in the latest
 replace github.com/vmware-tanzu/net-operator-api => ../../../net-operator-api
 
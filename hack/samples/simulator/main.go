@@ -0,0 +1,144 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/simulator"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+var testEnv *envtest.Environment
+
+const namespace string = "default"
+
+// Create a NetworkInterface on a simulated VSphereDistributedNetwork and print the status the
+// simulator realizes for it
+func main() {
+	fmt.Printf("Starting test env...\n")
+	config, err := startTestEnv()
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		fmt.Printf("Stopping test env...\n")
+		testEnv.Stop()
+	}()
+
+	fmt.Printf("Starting simulator...\n")
+	mgr, err := simulator.NewManager(config, simulator.Options{})
+	if err != nil {
+		panic(err)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		if err := mgr.Start(stop); err != nil {
+			panic(err)
+		}
+	}()
+
+	netOpClient, err := getNetOpClient(config)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Populating test env...\n")
+	if err := populateTestEnv(netOpClient); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Waiting for NetworkInterface to be ready...\n")
+	netIf := &v1alpha1.NetworkInterface{}
+	err = wait.PollImmediate(time.Second, time.Minute, func() (bool, error) {
+		key := ctrlClient.ObjectKey{Namespace: namespace, Name: "test-if1"}
+		if err := netOpClient.Get(context.TODO(), key, netIf); err != nil {
+			return false, err
+		}
+		return len(netIf.Status.IPConfigs) > 0, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("- %s: ip=%s mac=%s network=%s port=%s\n", netIf.Name, netIf.Status.IPConfigs[0].IP,
+		netIf.Status.MacAddress, netIf.Status.NetworkID, netIf.Status.PortID)
+}
+
+// Get a net-operator-api client using the simulator's scheme
+func getNetOpClient(config *rest.Config) (ctrlClient.Client, error) {
+	scheme, err := simulator.NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	return ctrlClient.New(config, ctrlClient.Options{
+		Scheme: scheme,
+	})
+}
+
+func startTestEnv() (*rest.Config, error) {
+	testEnv = &envtest.Environment{
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "..", "config", "crd", "bases"),
+		},
+	}
+
+	return testEnv.Start()
+}
+
+func populateTestEnv(client ctrlClient.Client) error {
+	objects := []runtime.Object{
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pool"},
+			Spec: v1alpha1.IPPoolSpec{
+				StartingAddress: "192.168.1.10",
+				AddressCount:    100,
+			},
+		},
+		&v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-vdn"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				IPPools:          []v1alpha1.IPPoolReference{{Name: "test-pool"}},
+				Gateway:          "192.168.1.1",
+				SubnetMask:       "255.255.255.0",
+			},
+		},
+		&v1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: namespace},
+			Spec: v1alpha1.NetworkSpec{
+				Type: v1alpha1.NetworkTypeVDS,
+				ProviderRef: v1alpha1.NetworkProviderReference{
+					APIGroup: v1alpha1.GroupName,
+					Kind:     "VSphereDistributedNetwork",
+					Name:     "test-vdn",
+				},
+			},
+		},
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-if1", Namespace: namespace},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NetworkName: "test-network",
+				Type:        v1alpha1.NetworkInterfaceTypeVMXNet3,
+			},
+		},
+	}
+	for _, obj := range objects {
+		if err := client.Create(context.TODO(), obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,7 +2,4 @@ module github.com/vmware-tanzu/net-operator-api/hack/tools
 
 go 1.13
 
-require (
-	k8s.io/code-generator v0.17.4
-	sigs.k8s.io/controller-tools v0.2.8
-)
+require k8s.io/code-generator v0.17.4
@@ -7,5 +7,7 @@
 package tools
 
 import (
-	_ "sigs.k8s.io/controller-tools/cmd/controller-gen"
+	_ "k8s.io/code-generator/cmd/client-gen"
+	_ "k8s.io/code-generator/cmd/informer-gen"
+	_ "k8s.io/code-generator/cmd/lister-gen"
 )
@@ -0,0 +1,53 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ipaddr parses IP addresses in their canonical length and converts
+// them to and from unsigned integers, for the validation of the API types and
+// the IP address allocator.
+package ipaddr
+
+import (
+	"math/big"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Parse parses s and returns the address in its canonical length, 4 bytes
+// for IPv4 and 16 bytes for IPv6, together with its family.
+func Parse(s string) (net.IP, corev1.IPFamily, bool) {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, "", false
+	}
+	return Canonical(ip)
+}
+
+// Canonical returns ip in its canonical length together with its family.
+func Canonical(ip net.IP) (net.IP, corev1.IPFamily, bool) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4, corev1.IPv4Protocol, true
+	}
+	if ip16 := ip.To16(); ip16 != nil {
+		return ip16, corev1.IPv6Protocol, true
+	}
+	return nil, "", false
+}
+
+// ToInt returns ip as an unsigned integer.
+func ToInt(ip net.IP) *big.Int {
+	return new(big.Int).SetBytes(ip)
+}
+
+// FromInt returns i as an address of the given family. The caller must ensure
+// i fits within the address length of the family.
+func FromInt(i *big.Int, family corev1.IPFamily) net.IP {
+	size := net.IPv6len
+	if family == corev1.IPv4Protocol {
+		size = net.IPv4len
+	}
+	b := i.Bytes()
+	ip := make(net.IP, size)
+	copy(ip[size-len(b):], b)
+	return ip
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipaddr
+
+import (
+	"net"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s      string
+		len    int
+		family corev1.IPFamily
+		ok     bool
+	}{
+		{"192.168.1.10", net.IPv4len, corev1.IPv4Protocol, true},
+		{"::ffff:192.168.1.10", net.IPv4len, corev1.IPv4Protocol, true},
+		{"fd00::10", net.IPv6len, corev1.IPv6Protocol, true},
+		{"192.168.1", 0, "", false},
+		{"", 0, "", false},
+	}
+	for _, tt := range tests {
+		ip, family, ok := Parse(tt.s)
+		if len(ip) != tt.len || family != tt.family || ok != tt.ok {
+			t.Errorf("Parse(%q) = %v, %q, %v, want %d bytes, %q, %v",
+				tt.s, ip, family, ok, tt.len, tt.family, tt.ok)
+		}
+	}
+}
+
+func TestToIntRoundTrip(t *testing.T) {
+	for _, s := range []string{"0.0.0.0", "192.168.1.10", "255.255.255.255", "::", "fd00::10", "ffff::ffff"} {
+		ip, family, _ := Parse(s)
+		if got := FromInt(ToInt(ip), family); !got.Equal(ip) || len(got) != len(ip) {
+			t.Errorf("FromInt(ToInt(%s)) = %v", s, got)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testenv starts envtest API servers with the CRDs of the
+// netoperator.vmware.com API group for the tests of the other packages.
+//
+// The CRDs are read from config/crd/bases, which is generated by running
+// `make generate-manifests`. `make test` generates the CRDs and installs the
+// envtest binaries in KUBEBUILDER_ASSETS. Tests using an Environment fail when
+// KUBEBUILDER_ASSETS is set but the CRDs have not been generated, and are
+// skipped by a plain `go test` without the envtest binaries.
+package testenv
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/pkg/webhook"
+)
+
+// Options are the options of an Environment.
+type Options struct {
+	// CRDDirectoryPaths are directories of additional CRDs to install, ex.
+	// the CRDs of Cluster API.
+	CRDDirectoryPaths []string
+
+	// Webhooks is true to serve the admission webhooks of all kinds and to
+	// register them with the API server.
+	Webhooks bool
+}
+
+// Environment is a running envtest API server.
+type Environment struct {
+	Config *rest.Config
+	Scheme *k8sruntime.Scheme
+
+	// Client is a client of the API server that does not read from a cache.
+	Client client.Client
+
+	env  *envtest.Environment
+	stop chan struct{}
+}
+
+// Start starts an API server with the CRDs of the netoperator.vmware.com API
+// group installed, or skips the test if the envtest binaries are not found.
+// Stop the returned Environment at the end of the test.
+func Start(t *testing.T, opts Options) *Environment {
+	t.Helper()
+
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		if _, err := os.Stat("/usr/local/kubebuilder/bin/kube-apiserver"); err != nil {
+			t.Skip("envtest binaries not found, run `make test` to run this test")
+		}
+	}
+	crdPath := filepath.Join(RootDir(), "config", "crd", "bases")
+	if _, err := os.Stat(crdPath); err != nil {
+		t.Fatal("CRDs not found, run `make generate-manifests`")
+	}
+
+	scheme := k8sruntime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	e := &Environment{
+		Scheme: scheme,
+		env: &envtest.Environment{
+			CRDDirectoryPaths:     append([]string{crdPath}, opts.CRDDirectoryPaths...),
+			ErrorIfCRDPathMissing: true,
+		},
+		stop: make(chan struct{}),
+	}
+	if opts.Webhooks {
+		mutating, validating, err := webhookConfigurations(scheme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e.env.WebhookInstallOptions = envtest.WebhookInstallOptions{
+			MutatingWebhooks:   []k8sruntime.Object{mutating},
+			ValidatingWebhooks: []k8sruntime.Object{validating},
+			PollInterval:       100 * time.Millisecond,
+			MaxTime:            10 * time.Second,
+		}
+	}
+
+	cfg, err := e.env.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Config = cfg
+
+	if opts.Webhooks {
+		if err := e.serveWebhooks(); err != nil {
+			e.Stop(t)
+			t.Fatal(err)
+		}
+	}
+
+	if e.Client, err = client.New(cfg, client.Options{Scheme: scheme}); err != nil {
+		e.Stop(t)
+		t.Fatal(err)
+	}
+	return e
+}
+
+// Stop stops the API server and the webhook server of the Environment.
+func (e *Environment) Stop(t *testing.T) {
+	t.Helper()
+	close(e.stop)
+	if err := e.env.Stop(); err != nil {
+		t.Error(err)
+	}
+}
+
+// serveWebhooks starts a manager serving the webhooks of all kinds at the
+// address and with the certificate registered with the API server by envtest.
+func (e *Environment) serveWebhooks() error {
+	opts := e.env.WebhookInstallOptions
+	mgr, err := ctrl.NewManager(e.Config, ctrl.Options{
+		Scheme:             e.Scheme,
+		MetricsBindAddress: "0",
+		Host:               opts.LocalServingHost,
+		Port:               opts.LocalServingPort,
+		CertDir:            opts.LocalServingCertDir,
+	})
+	if err != nil {
+		return err
+	}
+	if err := webhook.AddToManager(mgr); err != nil {
+		return err
+	}
+	go func() {
+		_ = mgr.Start(e.stop)
+	}()
+
+	// Wait for the webhook server to accept connections.
+	addr := net.JoinHostPort(opts.LocalServingHost, strconv.Itoa(opts.LocalServingPort))
+	return wait.PollImmediate(100*time.Millisecond, 10*time.Second, func() (bool, error) {
+		conn, err := tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true}) // nolint:gosec
+		if err != nil {
+			return false, nil
+		}
+		return true, conn.Close()
+	})
+}
+
+// webhookConfigurations returns the configurations of the mutating and
+// validating webhooks of all kinds. envtest replaces the service of each
+// webhook with the URL of the local webhook server, and joins the URL and the
+// path with a slash, so the paths do not start with one.
+func webhookConfigurations(scheme *k8sruntime.Scheme) (
+	*admissionregistrationv1beta1.MutatingWebhookConfiguration,
+	*admissionregistrationv1beta1.ValidatingWebhookConfiguration,
+	error) {
+
+	mutating := &admissionregistrationv1beta1.MutatingWebhookConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationv1beta1.SchemeGroupVersion.String(),
+			Kind:       "MutatingWebhookConfiguration",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "netoperator-mutating-webhook-configuration"},
+	}
+	for _, obj := range webhook.Defaulters() {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, nil, err
+		}
+		mutating.Webhooks = append(mutating.Webhooks, admissionregistrationv1beta1.MutatingWebhook{
+			Name:          "m" + strings.ToLower(gvk.Kind) + "." + gvk.Group,
+			ClientConfig:  clientConfig(webhook.MutatePath(gvk)),
+			Rules:         rules(gvk),
+			FailurePolicy: failurePolicy(),
+		})
+	}
+
+	validating := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationv1beta1.SchemeGroupVersion.String(),
+			Kind:       "ValidatingWebhookConfiguration",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "netoperator-validating-webhook-configuration"},
+	}
+	for _, obj := range webhook.Validators() {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, nil, err
+		}
+		validating.Webhooks = append(validating.Webhooks, admissionregistrationv1beta1.ValidatingWebhook{
+			Name:          "v" + strings.ToLower(gvk.Kind) + "." + gvk.Group,
+			ClientConfig:  clientConfig(webhook.ValidatePath(gvk)),
+			Rules:         rules(gvk),
+			FailurePolicy: failurePolicy(),
+		})
+	}
+
+	// The RequestedIPValidator only decodes v1alpha1 NetworkInterfaces, the
+	// API server converts the NetworkInterfaces of the other versions.
+	gvk := v1alpha1.SchemeGroupVersion.WithKind("NetworkInterface")
+	equivalent := admissionregistrationv1beta1.Equivalent
+	validating.Webhooks = append(validating.Webhooks, admissionregistrationv1beta1.ValidatingWebhook{
+		Name:          "vrequestedips.networkinterface." + gvk.Group,
+		ClientConfig:  clientConfig(webhook.ValidateRequestedIPsPath),
+		Rules:         rules(gvk),
+		FailurePolicy: failurePolicy(),
+		MatchPolicy:   &equivalent,
+	})
+	return mutating, validating, nil
+}
+
+func clientConfig(path string) admissionregistrationv1beta1.WebhookClientConfig {
+	path = strings.TrimPrefix(path, "/")
+	return admissionregistrationv1beta1.WebhookClientConfig{
+		Service: &admissionregistrationv1beta1.ServiceReference{
+			Namespace: "default",
+			Name:      "netoperator-webhook-service",
+			Path:      &path,
+		},
+	}
+}
+
+func rules(gvk schema.GroupVersionKind) []admissionregistrationv1beta1.RuleWithOperations {
+	return []admissionregistrationv1beta1.RuleWithOperations{{
+		Operations: []admissionregistrationv1beta1.OperationType{
+			admissionregistrationv1beta1.Create,
+			admissionregistrationv1beta1.Update,
+		},
+		Rule: admissionregistrationv1beta1.Rule{
+			APIGroups:   []string{gvk.Group},
+			APIVersions: []string{gvk.Version},
+			Resources:   []string{strings.ToLower(gvk.Kind) + "s"},
+		},
+	}}
+}
+
+func failurePolicy() *admissionregistrationv1beta1.FailurePolicyType {
+	policy := admissionregistrationv1beta1.Fail
+	return &policy
+}
+
+// RootDir returns the root directory of the repository.
+func RootDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..")
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package clientset
+
+import (
+	"fmt"
+
+	netoperatorv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/v1alpha1"
+	netoperatorv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/v1alpha2"
+	discovery "k8s.io/client-go/discovery"
+	rest "k8s.io/client-go/rest"
+	flowcontrol "k8s.io/client-go/util/flowcontrol"
+)
+
+type Interface interface {
+	Discovery() discovery.DiscoveryInterface
+	NetoperatorV1alpha1() netoperatorv1alpha1.NetoperatorV1alpha1Interface
+	NetoperatorV1alpha2() netoperatorv1alpha2.NetoperatorV1alpha2Interface
+}
+
+// Clientset contains the clients for groups. Each group has exactly one
+// version included in a Clientset.
+type Clientset struct {
+	*discovery.DiscoveryClient
+	netoperatorV1alpha1 *netoperatorv1alpha1.NetoperatorV1alpha1Client
+	netoperatorV1alpha2 *netoperatorv1alpha2.NetoperatorV1alpha2Client
+}
+
+// NetoperatorV1alpha1 retrieves the NetoperatorV1alpha1Client
+func (c *Clientset) NetoperatorV1alpha1() netoperatorv1alpha1.NetoperatorV1alpha1Interface {
+	return c.netoperatorV1alpha1
+}
+
+// NetoperatorV1alpha2 retrieves the NetoperatorV1alpha2Client
+func (c *Clientset) NetoperatorV1alpha2() netoperatorv1alpha2.NetoperatorV1alpha2Interface {
+	return c.netoperatorV1alpha2
+}
+
+// Discovery retrieves the DiscoveryClient
+func (c *Clientset) Discovery() discovery.DiscoveryInterface {
+	if c == nil {
+		return nil
+	}
+	return c.DiscoveryClient
+}
+
+// NewForConfig creates a new Clientset for the given config.
+// If config's RateLimiter is not set and QPS and Burst are acceptable,
+// NewForConfig will generate a rate-limiter in configShallowCopy.
+func NewForConfig(c *rest.Config) (*Clientset, error) {
+	configShallowCopy := *c
+	if configShallowCopy.RateLimiter == nil && configShallowCopy.QPS > 0 {
+		if configShallowCopy.Burst <= 0 {
+			return nil, fmt.Errorf("Burst is required to be greater than 0 when RateLimiter is not set and QPS is set to greater than 0")
+		}
+		configShallowCopy.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(configShallowCopy.QPS, configShallowCopy.Burst)
+	}
+	var cs Clientset
+	var err error
+	cs.netoperatorV1alpha1, err = netoperatorv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.netoperatorV1alpha2, err = netoperatorv1alpha2.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	return &cs, nil
+}
+
+// NewForConfigOrDie creates a new Clientset for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *Clientset {
+	var cs Clientset
+	cs.netoperatorV1alpha1 = netoperatorv1alpha1.NewForConfigOrDie(c)
+	cs.netoperatorV1alpha2 = netoperatorv1alpha2.NewForConfigOrDie(c)
+
+	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
+	return &cs
+}
+
+// New creates a new Clientset for the given RESTClient.
+func New(c rest.Interface) *Clientset {
+	var cs Clientset
+	cs.netoperatorV1alpha1 = netoperatorv1alpha1.New(c)
+	cs.netoperatorV1alpha2 = netoperatorv1alpha2.New(c)
+
+	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
+	return &cs
+}
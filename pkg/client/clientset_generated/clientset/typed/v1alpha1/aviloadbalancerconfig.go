@@ -0,0 +1,167 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AviLoadBalancerConfigsGetter has a method to return a AviLoadBalancerConfigInterface.
+// A group's client should implement this interface.
+type AviLoadBalancerConfigsGetter interface {
+	AviLoadBalancerConfigs() AviLoadBalancerConfigInterface
+}
+
+// AviLoadBalancerConfigInterface has methods to work with AviLoadBalancerConfig resources.
+type AviLoadBalancerConfigInterface interface {
+	Create(*v1alpha1.AviLoadBalancerConfig) (*v1alpha1.AviLoadBalancerConfig, error)
+	Update(*v1alpha1.AviLoadBalancerConfig) (*v1alpha1.AviLoadBalancerConfig, error)
+	UpdateStatus(*v1alpha1.AviLoadBalancerConfig) (*v1alpha1.AviLoadBalancerConfig, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.AviLoadBalancerConfig, error)
+	List(opts v1.ListOptions) (*v1alpha1.AviLoadBalancerConfigList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.AviLoadBalancerConfig, err error)
+	AviLoadBalancerConfigExpansion
+}
+
+// aviLoadBalancerConfigs implements AviLoadBalancerConfigInterface
+type aviLoadBalancerConfigs struct {
+	client rest.Interface
+}
+
+// newAviLoadBalancerConfigs returns a AviLoadBalancerConfigs
+func newAviLoadBalancerConfigs(c *NetoperatorV1alpha1Client) *aviLoadBalancerConfigs {
+	return &aviLoadBalancerConfigs{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the aviLoadBalancerConfig, and returns the corresponding aviLoadBalancerConfig object, and an error if there is any.
+func (c *aviLoadBalancerConfigs) Get(name string, options v1.GetOptions) (result *v1alpha1.AviLoadBalancerConfig, err error) {
+	result = &v1alpha1.AviLoadBalancerConfig{}
+	err = c.client.Get().
+		Resource("aviloadbalancerconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AviLoadBalancerConfigs that match those selectors.
+func (c *aviLoadBalancerConfigs) List(opts v1.ListOptions) (result *v1alpha1.AviLoadBalancerConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.AviLoadBalancerConfigList{}
+	err = c.client.Get().
+		Resource("aviloadbalancerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested aviLoadBalancerConfigs.
+func (c *aviLoadBalancerConfigs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("aviloadbalancerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a aviLoadBalancerConfig and creates it.  Returns the server's representation of the aviLoadBalancerConfig, and an error, if there is any.
+func (c *aviLoadBalancerConfigs) Create(aviLoadBalancerConfig *v1alpha1.AviLoadBalancerConfig) (result *v1alpha1.AviLoadBalancerConfig, err error) {
+	result = &v1alpha1.AviLoadBalancerConfig{}
+	err = c.client.Post().
+		Resource("aviloadbalancerconfigs").
+		Body(aviLoadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a aviLoadBalancerConfig and updates it. Returns the server's representation of the aviLoadBalancerConfig, and an error, if there is any.
+func (c *aviLoadBalancerConfigs) Update(aviLoadBalancerConfig *v1alpha1.AviLoadBalancerConfig) (result *v1alpha1.AviLoadBalancerConfig, err error) {
+	result = &v1alpha1.AviLoadBalancerConfig{}
+	err = c.client.Put().
+		Resource("aviloadbalancerconfigs").
+		Name(aviLoadBalancerConfig.Name).
+		Body(aviLoadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *aviLoadBalancerConfigs) UpdateStatus(aviLoadBalancerConfig *v1alpha1.AviLoadBalancerConfig) (result *v1alpha1.AviLoadBalancerConfig, err error) {
+	result = &v1alpha1.AviLoadBalancerConfig{}
+	err = c.client.Put().
+		Resource("aviloadbalancerconfigs").
+		Name(aviLoadBalancerConfig.Name).
+		SubResource("status").
+		Body(aviLoadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the aviLoadBalancerConfig and deletes it. Returns an error if one occurs.
+func (c *aviLoadBalancerConfigs) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("aviloadbalancerconfigs").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *aviLoadBalancerConfigs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("aviloadbalancerconfigs").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched aviLoadBalancerConfig.
+func (c *aviLoadBalancerConfigs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.AviLoadBalancerConfig, err error) {
+	result = &v1alpha1.AviLoadBalancerConfig{}
+	err = c.client.Patch(pt).
+		Resource("aviloadbalancerconfigs").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
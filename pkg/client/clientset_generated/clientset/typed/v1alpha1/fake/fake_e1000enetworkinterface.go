@@ -0,0 +1,127 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeE1000ENetworkInterfaces implements E1000ENetworkInterfaceInterface
+type FakeE1000ENetworkInterfaces struct {
+	Fake *FakeNetoperatorV1alpha1
+	ns   string
+}
+
+var e1000enetworkinterfacesResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha1", Resource: "e1000enetworkinterfaces"}
+
+var e1000enetworkinterfacesKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha1", Kind: "E1000ENetworkInterface"}
+
+// Get takes name of the e1000ENetworkInterface, and returns the corresponding e1000ENetworkInterface object, and an error if there is any.
+func (c *FakeE1000ENetworkInterfaces) Get(name string, options v1.GetOptions) (result *v1alpha1.E1000ENetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(e1000enetworkinterfacesResource, c.ns, name), &v1alpha1.E1000ENetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.E1000ENetworkInterface), err
+}
+
+// List takes label and field selectors, and returns the list of E1000ENetworkInterfaces that match those selectors.
+func (c *FakeE1000ENetworkInterfaces) List(opts v1.ListOptions) (result *v1alpha1.E1000ENetworkInterfaceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(e1000enetworkinterfacesResource, e1000enetworkinterfacesKind, c.ns, opts), &v1alpha1.E1000ENetworkInterfaceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.E1000ENetworkInterfaceList{ListMeta: obj.(*v1alpha1.E1000ENetworkInterfaceList).ListMeta}
+	for _, item := range obj.(*v1alpha1.E1000ENetworkInterfaceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested e1000ENetworkInterfaces.
+func (c *FakeE1000ENetworkInterfaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(e1000enetworkinterfacesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a e1000ENetworkInterface and creates it.  Returns the server's representation of the e1000ENetworkInterface, and an error, if there is any.
+func (c *FakeE1000ENetworkInterfaces) Create(e1000ENetworkInterface *v1alpha1.E1000ENetworkInterface) (result *v1alpha1.E1000ENetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(e1000enetworkinterfacesResource, c.ns, e1000ENetworkInterface), &v1alpha1.E1000ENetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.E1000ENetworkInterface), err
+}
+
+// Update takes the representation of a e1000ENetworkInterface and updates it. Returns the server's representation of the e1000ENetworkInterface, and an error, if there is any.
+func (c *FakeE1000ENetworkInterfaces) Update(e1000ENetworkInterface *v1alpha1.E1000ENetworkInterface) (result *v1alpha1.E1000ENetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(e1000enetworkinterfacesResource, c.ns, e1000ENetworkInterface), &v1alpha1.E1000ENetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.E1000ENetworkInterface), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeE1000ENetworkInterfaces) UpdateStatus(e1000ENetworkInterface *v1alpha1.E1000ENetworkInterface) (*v1alpha1.E1000ENetworkInterface, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(e1000enetworkinterfacesResource, "status", c.ns, e1000ENetworkInterface), &v1alpha1.E1000ENetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.E1000ENetworkInterface), err
+}
+
+// Delete takes name of the e1000ENetworkInterface and deletes it. Returns an error if one occurs.
+func (c *FakeE1000ENetworkInterfaces) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(e1000enetworkinterfacesResource, c.ns, name), &v1alpha1.E1000ENetworkInterface{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeE1000ENetworkInterfaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(e1000enetworkinterfacesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.E1000ENetworkInterfaceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched e1000ENetworkInterface.
+func (c *FakeE1000ENetworkInterfaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.E1000ENetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(e1000enetworkinterfacesResource, c.ns, name, pt, data, subresources...), &v1alpha1.E1000ENetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.E1000ENetworkInterface), err
+}
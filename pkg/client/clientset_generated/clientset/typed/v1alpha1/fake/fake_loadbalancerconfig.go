@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeLoadBalancerConfigs implements LoadBalancerConfigInterface
+type FakeLoadBalancerConfigs struct {
+	Fake *FakeNetoperatorV1alpha1
+}
+
+var loadbalancerconfigsResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha1", Resource: "loadbalancerconfigs"}
+
+var loadbalancerconfigsKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha1", Kind: "LoadBalancerConfig"}
+
+// Get takes name of the loadBalancerConfig, and returns the corresponding loadBalancerConfig object, and an error if there is any.
+func (c *FakeLoadBalancerConfigs) Get(name string, options v1.GetOptions) (result *v1alpha1.LoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(loadbalancerconfigsResource, name), &v1alpha1.LoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.LoadBalancerConfig), err
+}
+
+// List takes label and field selectors, and returns the list of LoadBalancerConfigs that match those selectors.
+func (c *FakeLoadBalancerConfigs) List(opts v1.ListOptions) (result *v1alpha1.LoadBalancerConfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(loadbalancerconfigsResource, loadbalancerconfigsKind, opts), &v1alpha1.LoadBalancerConfigList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.LoadBalancerConfigList{ListMeta: obj.(*v1alpha1.LoadBalancerConfigList).ListMeta}
+	for _, item := range obj.(*v1alpha1.LoadBalancerConfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested loadBalancerConfigs.
+func (c *FakeLoadBalancerConfigs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(loadbalancerconfigsResource, opts))
+}
+
+// Create takes the representation of a loadBalancerConfig and creates it.  Returns the server's representation of the loadBalancerConfig, and an error, if there is any.
+func (c *FakeLoadBalancerConfigs) Create(loadBalancerConfig *v1alpha1.LoadBalancerConfig) (result *v1alpha1.LoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(loadbalancerconfigsResource, loadBalancerConfig), &v1alpha1.LoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.LoadBalancerConfig), err
+}
+
+// Update takes the representation of a loadBalancerConfig and updates it. Returns the server's representation of the loadBalancerConfig, and an error, if there is any.
+func (c *FakeLoadBalancerConfigs) Update(loadBalancerConfig *v1alpha1.LoadBalancerConfig) (result *v1alpha1.LoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(loadbalancerconfigsResource, loadBalancerConfig), &v1alpha1.LoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.LoadBalancerConfig), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeLoadBalancerConfigs) UpdateStatus(loadBalancerConfig *v1alpha1.LoadBalancerConfig) (*v1alpha1.LoadBalancerConfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(loadbalancerconfigsResource, "status", loadBalancerConfig), &v1alpha1.LoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.LoadBalancerConfig), err
+}
+
+// Delete takes name of the loadBalancerConfig and deletes it. Returns an error if one occurs.
+func (c *FakeLoadBalancerConfigs) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(loadbalancerconfigsResource, name), &v1alpha1.LoadBalancerConfig{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeLoadBalancerConfigs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(loadbalancerconfigsResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.LoadBalancerConfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched loadBalancerConfig.
+func (c *FakeLoadBalancerConfigs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.LoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(loadbalancerconfigsResource, name, pt, data, subresources...), &v1alpha1.LoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.LoadBalancerConfig), err
+}
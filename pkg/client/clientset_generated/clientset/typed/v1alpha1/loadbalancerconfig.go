@@ -0,0 +1,167 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// LoadBalancerConfigsGetter has a method to return a LoadBalancerConfigInterface.
+// A group's client should implement this interface.
+type LoadBalancerConfigsGetter interface {
+	LoadBalancerConfigs() LoadBalancerConfigInterface
+}
+
+// LoadBalancerConfigInterface has methods to work with LoadBalancerConfig resources.
+type LoadBalancerConfigInterface interface {
+	Create(*v1alpha1.LoadBalancerConfig) (*v1alpha1.LoadBalancerConfig, error)
+	Update(*v1alpha1.LoadBalancerConfig) (*v1alpha1.LoadBalancerConfig, error)
+	UpdateStatus(*v1alpha1.LoadBalancerConfig) (*v1alpha1.LoadBalancerConfig, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.LoadBalancerConfig, error)
+	List(opts v1.ListOptions) (*v1alpha1.LoadBalancerConfigList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.LoadBalancerConfig, err error)
+	LoadBalancerConfigExpansion
+}
+
+// loadBalancerConfigs implements LoadBalancerConfigInterface
+type loadBalancerConfigs struct {
+	client rest.Interface
+}
+
+// newLoadBalancerConfigs returns a LoadBalancerConfigs
+func newLoadBalancerConfigs(c *NetoperatorV1alpha1Client) *loadBalancerConfigs {
+	return &loadBalancerConfigs{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the loadBalancerConfig, and returns the corresponding loadBalancerConfig object, and an error if there is any.
+func (c *loadBalancerConfigs) Get(name string, options v1.GetOptions) (result *v1alpha1.LoadBalancerConfig, err error) {
+	result = &v1alpha1.LoadBalancerConfig{}
+	err = c.client.Get().
+		Resource("loadbalancerconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of LoadBalancerConfigs that match those selectors.
+func (c *loadBalancerConfigs) List(opts v1.ListOptions) (result *v1alpha1.LoadBalancerConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.LoadBalancerConfigList{}
+	err = c.client.Get().
+		Resource("loadbalancerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested loadBalancerConfigs.
+func (c *loadBalancerConfigs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("loadbalancerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a loadBalancerConfig and creates it.  Returns the server's representation of the loadBalancerConfig, and an error, if there is any.
+func (c *loadBalancerConfigs) Create(loadBalancerConfig *v1alpha1.LoadBalancerConfig) (result *v1alpha1.LoadBalancerConfig, err error) {
+	result = &v1alpha1.LoadBalancerConfig{}
+	err = c.client.Post().
+		Resource("loadbalancerconfigs").
+		Body(loadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a loadBalancerConfig and updates it. Returns the server's representation of the loadBalancerConfig, and an error, if there is any.
+func (c *loadBalancerConfigs) Update(loadBalancerConfig *v1alpha1.LoadBalancerConfig) (result *v1alpha1.LoadBalancerConfig, err error) {
+	result = &v1alpha1.LoadBalancerConfig{}
+	err = c.client.Put().
+		Resource("loadbalancerconfigs").
+		Name(loadBalancerConfig.Name).
+		Body(loadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *loadBalancerConfigs) UpdateStatus(loadBalancerConfig *v1alpha1.LoadBalancerConfig) (result *v1alpha1.LoadBalancerConfig, err error) {
+	result = &v1alpha1.LoadBalancerConfig{}
+	err = c.client.Put().
+		Resource("loadbalancerconfigs").
+		Name(loadBalancerConfig.Name).
+		SubResource("status").
+		Body(loadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the loadBalancerConfig and deletes it. Returns an error if one occurs.
+func (c *loadBalancerConfigs) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("loadbalancerconfigs").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *loadBalancerConfigs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("loadbalancerconfigs").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched loadBalancerConfig.
+func (c *loadBalancerConfigs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.LoadBalancerConfig, err error) {
+	result = &v1alpha1.LoadBalancerConfig{}
+	err = c.client.Patch(pt).
+		Resource("loadbalancerconfigs").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NSXTNetworksGetter has a method to return a NSXTNetworkInterface.
+// A group's client should implement this interface.
+type NSXTNetworksGetter interface {
+	NSXTNetworks() NSXTNetworkInterface
+}
+
+// NSXTNetworkInterface has methods to work with NSXTNetwork resources.
+type NSXTNetworkInterface interface {
+	Create(*v1alpha1.NSXTNetwork) (*v1alpha1.NSXTNetwork, error)
+	Update(*v1alpha1.NSXTNetwork) (*v1alpha1.NSXTNetwork, error)
+	UpdateStatus(*v1alpha1.NSXTNetwork) (*v1alpha1.NSXTNetwork, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.NSXTNetwork, error)
+	List(opts v1.ListOptions) (*v1alpha1.NSXTNetworkList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.NSXTNetwork, err error)
+	NSXTNetworkExpansion
+}
+
+// nSXTNetworks implements NSXTNetworkInterface
+type nSXTNetworks struct {
+	client rest.Interface
+}
+
+// newNSXTNetworks returns a NSXTNetworks
+func newNSXTNetworks(c *NetoperatorV1alpha1Client) *nSXTNetworks {
+	return &nSXTNetworks{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the nSXTNetwork, and returns the corresponding nSXTNetwork object, and an error if there is any.
+func (c *nSXTNetworks) Get(name string, options v1.GetOptions) (result *v1alpha1.NSXTNetwork, err error) {
+	result = &v1alpha1.NSXTNetwork{}
+	err = c.client.Get().
+		Resource("nsxtnetworks").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NSXTNetworks that match those selectors.
+func (c *nSXTNetworks) List(opts v1.ListOptions) (result *v1alpha1.NSXTNetworkList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.NSXTNetworkList{}
+	err = c.client.Get().
+		Resource("nsxtnetworks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested nSXTNetworks.
+func (c *nSXTNetworks) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("nsxtnetworks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a nSXTNetwork and creates it.  Returns the server's representation of the nSXTNetwork, and an error, if there is any.
+func (c *nSXTNetworks) Create(nSXTNetwork *v1alpha1.NSXTNetwork) (result *v1alpha1.NSXTNetwork, err error) {
+	result = &v1alpha1.NSXTNetwork{}
+	err = c.client.Post().
+		Resource("nsxtnetworks").
+		Body(nSXTNetwork).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a nSXTNetwork and updates it. Returns the server's representation of the nSXTNetwork, and an error, if there is any.
+func (c *nSXTNetworks) Update(nSXTNetwork *v1alpha1.NSXTNetwork) (result *v1alpha1.NSXTNetwork, err error) {
+	result = &v1alpha1.NSXTNetwork{}
+	err = c.client.Put().
+		Resource("nsxtnetworks").
+		Name(nSXTNetwork.Name).
+		Body(nSXTNetwork).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *nSXTNetworks) UpdateStatus(nSXTNetwork *v1alpha1.NSXTNetwork) (result *v1alpha1.NSXTNetwork, err error) {
+	result = &v1alpha1.NSXTNetwork{}
+	err = c.client.Put().
+		Resource("nsxtnetworks").
+		Name(nSXTNetwork.Name).
+		SubResource("status").
+		Body(nSXTNetwork).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the nSXTNetwork and deletes it. Returns an error if one occurs.
+func (c *nSXTNetworks) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("nsxtnetworks").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *nSXTNetworks) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("nsxtnetworks").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched nSXTNetwork.
+func (c *nSXTNetworks) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.NSXTNetwork, err error) {
+	result = &v1alpha1.NSXTNetwork{}
+	err = c.client.Patch(pt).
+		Resource("nsxtnetworks").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VMXNET3NetworkInterfacesGetter has a method to return a VMXNET3NetworkInterfaceInterface.
+// A group's client should implement this interface.
+type VMXNET3NetworkInterfacesGetter interface {
+	VMXNET3NetworkInterfaces(namespace string) VMXNET3NetworkInterfaceInterface
+}
+
+// VMXNET3NetworkInterfaceInterface has methods to work with VMXNET3NetworkInterface resources.
+type VMXNET3NetworkInterfaceInterface interface {
+	Create(*v1alpha1.VMXNET3NetworkInterface) (*v1alpha1.VMXNET3NetworkInterface, error)
+	Update(*v1alpha1.VMXNET3NetworkInterface) (*v1alpha1.VMXNET3NetworkInterface, error)
+	UpdateStatus(*v1alpha1.VMXNET3NetworkInterface) (*v1alpha1.VMXNET3NetworkInterface, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.VMXNET3NetworkInterface, error)
+	List(opts v1.ListOptions) (*v1alpha1.VMXNET3NetworkInterfaceList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.VMXNET3NetworkInterface, err error)
+	VMXNET3NetworkInterfaceExpansion
+}
+
+// vMXNET3NetworkInterfaces implements VMXNET3NetworkInterfaceInterface
+type vMXNET3NetworkInterfaces struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVMXNET3NetworkInterfaces returns a VMXNET3NetworkInterfaces
+func newVMXNET3NetworkInterfaces(c *NetoperatorV1alpha1Client, namespace string) *vMXNET3NetworkInterfaces {
+	return &vMXNET3NetworkInterfaces{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the vMXNET3NetworkInterface, and returns the corresponding vMXNET3NetworkInterface object, and an error if there is any.
+func (c *vMXNET3NetworkInterfaces) Get(name string, options v1.GetOptions) (result *v1alpha1.VMXNET3NetworkInterface, err error) {
+	result = &v1alpha1.VMXNET3NetworkInterface{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VMXNET3NetworkInterfaces that match those selectors.
+func (c *vMXNET3NetworkInterfaces) List(opts v1.ListOptions) (result *v1alpha1.VMXNET3NetworkInterfaceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.VMXNET3NetworkInterfaceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested vMXNET3NetworkInterfaces.
+func (c *vMXNET3NetworkInterfaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a vMXNET3NetworkInterface and creates it.  Returns the server's representation of the vMXNET3NetworkInterface, and an error, if there is any.
+func (c *vMXNET3NetworkInterfaces) Create(vMXNET3NetworkInterface *v1alpha1.VMXNET3NetworkInterface) (result *v1alpha1.VMXNET3NetworkInterface, err error) {
+	result = &v1alpha1.VMXNET3NetworkInterface{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		Body(vMXNET3NetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a vMXNET3NetworkInterface and updates it. Returns the server's representation of the vMXNET3NetworkInterface, and an error, if there is any.
+func (c *vMXNET3NetworkInterfaces) Update(vMXNET3NetworkInterface *v1alpha1.VMXNET3NetworkInterface) (result *v1alpha1.VMXNET3NetworkInterface, err error) {
+	result = &v1alpha1.VMXNET3NetworkInterface{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		Name(vMXNET3NetworkInterface.Name).
+		Body(vMXNET3NetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *vMXNET3NetworkInterfaces) UpdateStatus(vMXNET3NetworkInterface *v1alpha1.VMXNET3NetworkInterface) (result *v1alpha1.VMXNET3NetworkInterface, err error) {
+	result = &v1alpha1.VMXNET3NetworkInterface{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		Name(vMXNET3NetworkInterface.Name).
+		SubResource("status").
+		Body(vMXNET3NetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the vMXNET3NetworkInterface and deletes it. Returns an error if one occurs.
+func (c *vMXNET3NetworkInterfaces) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *vMXNET3NetworkInterfaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched vMXNET3NetworkInterface.
+func (c *vMXNET3NetworkInterfaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.VMXNET3NetworkInterface, err error) {
+	result = &v1alpha1.VMXNET3NetworkInterface{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("vmxnet3networkinterfaces").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
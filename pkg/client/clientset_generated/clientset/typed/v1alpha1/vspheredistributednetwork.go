@@ -0,0 +1,167 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// VSphereDistributedNetworksGetter has a method to return a VSphereDistributedNetworkInterface.
+// A group's client should implement this interface.
+type VSphereDistributedNetworksGetter interface {
+	VSphereDistributedNetworks() VSphereDistributedNetworkInterface
+}
+
+// VSphereDistributedNetworkInterface has methods to work with VSphereDistributedNetwork resources.
+type VSphereDistributedNetworkInterface interface {
+	Create(*v1alpha1.VSphereDistributedNetwork) (*v1alpha1.VSphereDistributedNetwork, error)
+	Update(*v1alpha1.VSphereDistributedNetwork) (*v1alpha1.VSphereDistributedNetwork, error)
+	UpdateStatus(*v1alpha1.VSphereDistributedNetwork) (*v1alpha1.VSphereDistributedNetwork, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.VSphereDistributedNetwork, error)
+	List(opts v1.ListOptions) (*v1alpha1.VSphereDistributedNetworkList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.VSphereDistributedNetwork, err error)
+	VSphereDistributedNetworkExpansion
+}
+
+// vSphereDistributedNetworks implements VSphereDistributedNetworkInterface
+type vSphereDistributedNetworks struct {
+	client rest.Interface
+}
+
+// newVSphereDistributedNetworks returns a VSphereDistributedNetworks
+func newVSphereDistributedNetworks(c *NetoperatorV1alpha1Client) *vSphereDistributedNetworks {
+	return &vSphereDistributedNetworks{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the vSphereDistributedNetwork, and returns the corresponding vSphereDistributedNetwork object, and an error if there is any.
+func (c *vSphereDistributedNetworks) Get(name string, options v1.GetOptions) (result *v1alpha1.VSphereDistributedNetwork, err error) {
+	result = &v1alpha1.VSphereDistributedNetwork{}
+	err = c.client.Get().
+		Resource("vspheredistributednetworks").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VSphereDistributedNetworks that match those selectors.
+func (c *vSphereDistributedNetworks) List(opts v1.ListOptions) (result *v1alpha1.VSphereDistributedNetworkList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.VSphereDistributedNetworkList{}
+	err = c.client.Get().
+		Resource("vspheredistributednetworks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested vSphereDistributedNetworks.
+func (c *vSphereDistributedNetworks) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("vspheredistributednetworks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a vSphereDistributedNetwork and creates it.  Returns the server's representation of the vSphereDistributedNetwork, and an error, if there is any.
+func (c *vSphereDistributedNetworks) Create(vSphereDistributedNetwork *v1alpha1.VSphereDistributedNetwork) (result *v1alpha1.VSphereDistributedNetwork, err error) {
+	result = &v1alpha1.VSphereDistributedNetwork{}
+	err = c.client.Post().
+		Resource("vspheredistributednetworks").
+		Body(vSphereDistributedNetwork).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a vSphereDistributedNetwork and updates it. Returns the server's representation of the vSphereDistributedNetwork, and an error, if there is any.
+func (c *vSphereDistributedNetworks) Update(vSphereDistributedNetwork *v1alpha1.VSphereDistributedNetwork) (result *v1alpha1.VSphereDistributedNetwork, err error) {
+	result = &v1alpha1.VSphereDistributedNetwork{}
+	err = c.client.Put().
+		Resource("vspheredistributednetworks").
+		Name(vSphereDistributedNetwork.Name).
+		Body(vSphereDistributedNetwork).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *vSphereDistributedNetworks) UpdateStatus(vSphereDistributedNetwork *v1alpha1.VSphereDistributedNetwork) (result *v1alpha1.VSphereDistributedNetwork, err error) {
+	result = &v1alpha1.VSphereDistributedNetwork{}
+	err = c.client.Put().
+		Resource("vspheredistributednetworks").
+		Name(vSphereDistributedNetwork.Name).
+		SubResource("status").
+		Body(vSphereDistributedNetwork).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the vSphereDistributedNetwork and deletes it. Returns an error if one occurs.
+func (c *vSphereDistributedNetworks) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("vspheredistributednetworks").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *vSphereDistributedNetworks) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("vspheredistributednetworks").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched vSphereDistributedNetwork.
+func (c *vSphereDistributedNetworks) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.VSphereDistributedNetwork, err error) {
+	result = &v1alpha1.VSphereDistributedNetwork{}
+	err = c.client.Patch(pt).
+		Resource("vspheredistributednetworks").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+type NetoperatorV1alpha2Interface interface {
+	RESTClient() rest.Interface
+	AviLoadBalancerConfigsGetter
+	E1000ENetworkInterfacesGetter
+	HAProxyLoadBalancerConfigsGetter
+	IPPoolsGetter
+	LoadBalancerConfigsGetter
+	NSXTNetworksGetter
+	NetworksGetter
+	NetworkInterfacesGetter
+	SRIOVNetworkInterfacesGetter
+	VMXNET3NetworkInterfacesGetter
+	VSphereDistributedNetworksGetter
+}
+
+// NetoperatorV1alpha2Client is used to interact with features provided by the netoperator.vmware.com group.
+type NetoperatorV1alpha2Client struct {
+	restClient rest.Interface
+}
+
+func (c *NetoperatorV1alpha2Client) AviLoadBalancerConfigs() AviLoadBalancerConfigInterface {
+	return newAviLoadBalancerConfigs(c)
+}
+
+func (c *NetoperatorV1alpha2Client) E1000ENetworkInterfaces(namespace string) E1000ENetworkInterfaceInterface {
+	return newE1000ENetworkInterfaces(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) HAProxyLoadBalancerConfigs() HAProxyLoadBalancerConfigInterface {
+	return newHAProxyLoadBalancerConfigs(c)
+}
+
+func (c *NetoperatorV1alpha2Client) IPPools() IPPoolInterface {
+	return newIPPools(c)
+}
+
+func (c *NetoperatorV1alpha2Client) LoadBalancerConfigs() LoadBalancerConfigInterface {
+	return newLoadBalancerConfigs(c)
+}
+
+func (c *NetoperatorV1alpha2Client) NSXTNetworks() NSXTNetworkInterface {
+	return newNSXTNetworks(c)
+}
+
+func (c *NetoperatorV1alpha2Client) Networks(namespace string) NetworkInterface {
+	return newNetworks(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) NetworkInterfaces(namespace string) NetworkInterfaceInterface {
+	return newNetworkInterfaces(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) SRIOVNetworkInterfaces(namespace string) SRIOVNetworkInterfaceInterface {
+	return newSRIOVNetworkInterfaces(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) VMXNET3NetworkInterfaces(namespace string) VMXNET3NetworkInterfaceInterface {
+	return newVMXNET3NetworkInterfaces(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) VSphereDistributedNetworks() VSphereDistributedNetworkInterface {
+	return newVSphereDistributedNetworks(c)
+}
+
+// NewForConfig creates a new NetoperatorV1alpha2Client for the given config.
+func NewForConfig(c *rest.Config) (*NetoperatorV1alpha2Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &NetoperatorV1alpha2Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new NetoperatorV1alpha2Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *NetoperatorV1alpha2Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new NetoperatorV1alpha2Client for the given RESTClient.
+func New(c rest.Interface) *NetoperatorV1alpha2Client {
+	return &NetoperatorV1alpha2Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1alpha2.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *NetoperatorV1alpha2Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
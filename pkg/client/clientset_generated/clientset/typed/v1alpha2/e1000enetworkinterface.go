@@ -0,0 +1,178 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// E1000ENetworkInterfacesGetter has a method to return a E1000ENetworkInterfaceInterface.
+// A group's client should implement this interface.
+type E1000ENetworkInterfacesGetter interface {
+	E1000ENetworkInterfaces(namespace string) E1000ENetworkInterfaceInterface
+}
+
+// E1000ENetworkInterfaceInterface has methods to work with E1000ENetworkInterface resources.
+type E1000ENetworkInterfaceInterface interface {
+	Create(*v1alpha2.E1000ENetworkInterface) (*v1alpha2.E1000ENetworkInterface, error)
+	Update(*v1alpha2.E1000ENetworkInterface) (*v1alpha2.E1000ENetworkInterface, error)
+	UpdateStatus(*v1alpha2.E1000ENetworkInterface) (*v1alpha2.E1000ENetworkInterface, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.E1000ENetworkInterface, error)
+	List(opts v1.ListOptions) (*v1alpha2.E1000ENetworkInterfaceList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.E1000ENetworkInterface, err error)
+	E1000ENetworkInterfaceExpansion
+}
+
+// e1000ENetworkInterfaces implements E1000ENetworkInterfaceInterface
+type e1000ENetworkInterfaces struct {
+	client rest.Interface
+	ns     string
+}
+
+// newE1000ENetworkInterfaces returns a E1000ENetworkInterfaces
+func newE1000ENetworkInterfaces(c *NetoperatorV1alpha2Client, namespace string) *e1000ENetworkInterfaces {
+	return &e1000ENetworkInterfaces{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the e1000ENetworkInterface, and returns the corresponding e1000ENetworkInterface object, and an error if there is any.
+func (c *e1000ENetworkInterfaces) Get(name string, options v1.GetOptions) (result *v1alpha2.E1000ENetworkInterface, err error) {
+	result = &v1alpha2.E1000ENetworkInterface{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of E1000ENetworkInterfaces that match those selectors.
+func (c *e1000ENetworkInterfaces) List(opts v1.ListOptions) (result *v1alpha2.E1000ENetworkInterfaceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.E1000ENetworkInterfaceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested e1000ENetworkInterfaces.
+func (c *e1000ENetworkInterfaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a e1000ENetworkInterface and creates it.  Returns the server's representation of the e1000ENetworkInterface, and an error, if there is any.
+func (c *e1000ENetworkInterfaces) Create(e1000ENetworkInterface *v1alpha2.E1000ENetworkInterface) (result *v1alpha2.E1000ENetworkInterface, err error) {
+	result = &v1alpha2.E1000ENetworkInterface{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		Body(e1000ENetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a e1000ENetworkInterface and updates it. Returns the server's representation of the e1000ENetworkInterface, and an error, if there is any.
+func (c *e1000ENetworkInterfaces) Update(e1000ENetworkInterface *v1alpha2.E1000ENetworkInterface) (result *v1alpha2.E1000ENetworkInterface, err error) {
+	result = &v1alpha2.E1000ENetworkInterface{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		Name(e1000ENetworkInterface.Name).
+		Body(e1000ENetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *e1000ENetworkInterfaces) UpdateStatus(e1000ENetworkInterface *v1alpha2.E1000ENetworkInterface) (result *v1alpha2.E1000ENetworkInterface, err error) {
+	result = &v1alpha2.E1000ENetworkInterface{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		Name(e1000ENetworkInterface.Name).
+		SubResource("status").
+		Body(e1000ENetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the e1000ENetworkInterface and deletes it. Returns an error if one occurs.
+func (c *e1000ENetworkInterfaces) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *e1000ENetworkInterfaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched e1000ENetworkInterface.
+func (c *e1000ENetworkInterfaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.E1000ENetworkInterface, err error) {
+	result = &v1alpha2.E1000ENetworkInterface{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("e1000enetworkinterfaces").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAviLoadBalancerConfigs implements AviLoadBalancerConfigInterface
+type FakeAviLoadBalancerConfigs struct {
+	Fake *FakeNetoperatorV1alpha2
+}
+
+var aviloadbalancerconfigsResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "aviloadbalancerconfigs"}
+
+var aviloadbalancerconfigsKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "AviLoadBalancerConfig"}
+
+// Get takes name of the aviLoadBalancerConfig, and returns the corresponding aviLoadBalancerConfig object, and an error if there is any.
+func (c *FakeAviLoadBalancerConfigs) Get(name string, options v1.GetOptions) (result *v1alpha2.AviLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(aviloadbalancerconfigsResource, name), &v1alpha2.AviLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.AviLoadBalancerConfig), err
+}
+
+// List takes label and field selectors, and returns the list of AviLoadBalancerConfigs that match those selectors.
+func (c *FakeAviLoadBalancerConfigs) List(opts v1.ListOptions) (result *v1alpha2.AviLoadBalancerConfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(aviloadbalancerconfigsResource, aviloadbalancerconfigsKind, opts), &v1alpha2.AviLoadBalancerConfigList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.AviLoadBalancerConfigList{ListMeta: obj.(*v1alpha2.AviLoadBalancerConfigList).ListMeta}
+	for _, item := range obj.(*v1alpha2.AviLoadBalancerConfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested aviLoadBalancerConfigs.
+func (c *FakeAviLoadBalancerConfigs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(aviloadbalancerconfigsResource, opts))
+}
+
+// Create takes the representation of a aviLoadBalancerConfig and creates it.  Returns the server's representation of the aviLoadBalancerConfig, and an error, if there is any.
+func (c *FakeAviLoadBalancerConfigs) Create(aviLoadBalancerConfig *v1alpha2.AviLoadBalancerConfig) (result *v1alpha2.AviLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(aviloadbalancerconfigsResource, aviLoadBalancerConfig), &v1alpha2.AviLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.AviLoadBalancerConfig), err
+}
+
+// Update takes the representation of a aviLoadBalancerConfig and updates it. Returns the server's representation of the aviLoadBalancerConfig, and an error, if there is any.
+func (c *FakeAviLoadBalancerConfigs) Update(aviLoadBalancerConfig *v1alpha2.AviLoadBalancerConfig) (result *v1alpha2.AviLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(aviloadbalancerconfigsResource, aviLoadBalancerConfig), &v1alpha2.AviLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.AviLoadBalancerConfig), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAviLoadBalancerConfigs) UpdateStatus(aviLoadBalancerConfig *v1alpha2.AviLoadBalancerConfig) (*v1alpha2.AviLoadBalancerConfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(aviloadbalancerconfigsResource, "status", aviLoadBalancerConfig), &v1alpha2.AviLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.AviLoadBalancerConfig), err
+}
+
+// Delete takes name of the aviLoadBalancerConfig and deletes it. Returns an error if one occurs.
+func (c *FakeAviLoadBalancerConfigs) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(aviloadbalancerconfigsResource, name), &v1alpha2.AviLoadBalancerConfig{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAviLoadBalancerConfigs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(aviloadbalancerconfigsResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.AviLoadBalancerConfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched aviLoadBalancerConfig.
+func (c *FakeAviLoadBalancerConfigs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.AviLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(aviloadbalancerconfigsResource, name, pt, data, subresources...), &v1alpha2.AviLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.AviLoadBalancerConfig), err
+}
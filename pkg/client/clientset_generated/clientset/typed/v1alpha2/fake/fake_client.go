@@ -0,0 +1,67 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/v1alpha2"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeNetoperatorV1alpha2 struct {
+	*testing.Fake
+}
+
+func (c *FakeNetoperatorV1alpha2) AviLoadBalancerConfigs() v1alpha2.AviLoadBalancerConfigInterface {
+	return &FakeAviLoadBalancerConfigs{c}
+}
+
+func (c *FakeNetoperatorV1alpha2) E1000ENetworkInterfaces(namespace string) v1alpha2.E1000ENetworkInterfaceInterface {
+	return &FakeE1000ENetworkInterfaces{c, namespace}
+}
+
+func (c *FakeNetoperatorV1alpha2) HAProxyLoadBalancerConfigs() v1alpha2.HAProxyLoadBalancerConfigInterface {
+	return &FakeHAProxyLoadBalancerConfigs{c}
+}
+
+func (c *FakeNetoperatorV1alpha2) IPPools() v1alpha2.IPPoolInterface {
+	return &FakeIPPools{c}
+}
+
+func (c *FakeNetoperatorV1alpha2) LoadBalancerConfigs() v1alpha2.LoadBalancerConfigInterface {
+	return &FakeLoadBalancerConfigs{c}
+}
+
+func (c *FakeNetoperatorV1alpha2) NSXTNetworks() v1alpha2.NSXTNetworkInterface {
+	return &FakeNSXTNetworks{c}
+}
+
+func (c *FakeNetoperatorV1alpha2) Networks(namespace string) v1alpha2.NetworkInterface {
+	return &FakeNetworks{c, namespace}
+}
+
+func (c *FakeNetoperatorV1alpha2) NetworkInterfaces(namespace string) v1alpha2.NetworkInterfaceInterface {
+	return &FakeNetworkInterfaces{c, namespace}
+}
+
+func (c *FakeNetoperatorV1alpha2) SRIOVNetworkInterfaces(namespace string) v1alpha2.SRIOVNetworkInterfaceInterface {
+	return &FakeSRIOVNetworkInterfaces{c, namespace}
+}
+
+func (c *FakeNetoperatorV1alpha2) VMXNET3NetworkInterfaces(namespace string) v1alpha2.VMXNET3NetworkInterfaceInterface {
+	return &FakeVMXNET3NetworkInterfaces{c, namespace}
+}
+
+func (c *FakeNetoperatorV1alpha2) VSphereDistributedNetworks() v1alpha2.VSphereDistributedNetworkInterface {
+	return &FakeVSphereDistributedNetworks{c}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeNetoperatorV1alpha2) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeHAProxyLoadBalancerConfigs implements HAProxyLoadBalancerConfigInterface
+type FakeHAProxyLoadBalancerConfigs struct {
+	Fake *FakeNetoperatorV1alpha2
+}
+
+var haproxyloadbalancerconfigsResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "haproxyloadbalancerconfigs"}
+
+var haproxyloadbalancerconfigsKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "HAProxyLoadBalancerConfig"}
+
+// Get takes name of the hAProxyLoadBalancerConfig, and returns the corresponding hAProxyLoadBalancerConfig object, and an error if there is any.
+func (c *FakeHAProxyLoadBalancerConfigs) Get(name string, options v1.GetOptions) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(haproxyloadbalancerconfigsResource, name), &v1alpha2.HAProxyLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.HAProxyLoadBalancerConfig), err
+}
+
+// List takes label and field selectors, and returns the list of HAProxyLoadBalancerConfigs that match those selectors.
+func (c *FakeHAProxyLoadBalancerConfigs) List(opts v1.ListOptions) (result *v1alpha2.HAProxyLoadBalancerConfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(haproxyloadbalancerconfigsResource, haproxyloadbalancerconfigsKind, opts), &v1alpha2.HAProxyLoadBalancerConfigList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.HAProxyLoadBalancerConfigList{ListMeta: obj.(*v1alpha2.HAProxyLoadBalancerConfigList).ListMeta}
+	for _, item := range obj.(*v1alpha2.HAProxyLoadBalancerConfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested hAProxyLoadBalancerConfigs.
+func (c *FakeHAProxyLoadBalancerConfigs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(haproxyloadbalancerconfigsResource, opts))
+}
+
+// Create takes the representation of a hAProxyLoadBalancerConfig and creates it.  Returns the server's representation of the hAProxyLoadBalancerConfig, and an error, if there is any.
+func (c *FakeHAProxyLoadBalancerConfigs) Create(hAProxyLoadBalancerConfig *v1alpha2.HAProxyLoadBalancerConfig) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(haproxyloadbalancerconfigsResource, hAProxyLoadBalancerConfig), &v1alpha2.HAProxyLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.HAProxyLoadBalancerConfig), err
+}
+
+// Update takes the representation of a hAProxyLoadBalancerConfig and updates it. Returns the server's representation of the hAProxyLoadBalancerConfig, and an error, if there is any.
+func (c *FakeHAProxyLoadBalancerConfigs) Update(hAProxyLoadBalancerConfig *v1alpha2.HAProxyLoadBalancerConfig) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(haproxyloadbalancerconfigsResource, hAProxyLoadBalancerConfig), &v1alpha2.HAProxyLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.HAProxyLoadBalancerConfig), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeHAProxyLoadBalancerConfigs) UpdateStatus(hAProxyLoadBalancerConfig *v1alpha2.HAProxyLoadBalancerConfig) (*v1alpha2.HAProxyLoadBalancerConfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(haproxyloadbalancerconfigsResource, "status", hAProxyLoadBalancerConfig), &v1alpha2.HAProxyLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.HAProxyLoadBalancerConfig), err
+}
+
+// Delete takes name of the hAProxyLoadBalancerConfig and deletes it. Returns an error if one occurs.
+func (c *FakeHAProxyLoadBalancerConfigs) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(haproxyloadbalancerconfigsResource, name), &v1alpha2.HAProxyLoadBalancerConfig{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeHAProxyLoadBalancerConfigs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(haproxyloadbalancerconfigsResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.HAProxyLoadBalancerConfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched hAProxyLoadBalancerConfig.
+func (c *FakeHAProxyLoadBalancerConfigs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(haproxyloadbalancerconfigsResource, name, pt, data, subresources...), &v1alpha2.HAProxyLoadBalancerConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.HAProxyLoadBalancerConfig), err
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeIPPools implements IPPoolInterface
+type FakeIPPools struct {
+	Fake *FakeNetoperatorV1alpha2
+}
+
+var ippoolsResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "ippools"}
+
+var ippoolsKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "IPPool"}
+
+// Get takes name of the iPPool, and returns the corresponding iPPool object, and an error if there is any.
+func (c *FakeIPPools) Get(name string, options v1.GetOptions) (result *v1alpha2.IPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(ippoolsResource, name), &v1alpha2.IPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.IPPool), err
+}
+
+// List takes label and field selectors, and returns the list of IPPools that match those selectors.
+func (c *FakeIPPools) List(opts v1.ListOptions) (result *v1alpha2.IPPoolList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(ippoolsResource, ippoolsKind, opts), &v1alpha2.IPPoolList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.IPPoolList{ListMeta: obj.(*v1alpha2.IPPoolList).ListMeta}
+	for _, item := range obj.(*v1alpha2.IPPoolList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested iPPools.
+func (c *FakeIPPools) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(ippoolsResource, opts))
+}
+
+// Create takes the representation of a iPPool and creates it.  Returns the server's representation of the iPPool, and an error, if there is any.
+func (c *FakeIPPools) Create(iPPool *v1alpha2.IPPool) (result *v1alpha2.IPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(ippoolsResource, iPPool), &v1alpha2.IPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.IPPool), err
+}
+
+// Update takes the representation of a iPPool and updates it. Returns the server's representation of the iPPool, and an error, if there is any.
+func (c *FakeIPPools) Update(iPPool *v1alpha2.IPPool) (result *v1alpha2.IPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(ippoolsResource, iPPool), &v1alpha2.IPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.IPPool), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeIPPools) UpdateStatus(iPPool *v1alpha2.IPPool) (*v1alpha2.IPPool, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(ippoolsResource, "status", iPPool), &v1alpha2.IPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.IPPool), err
+}
+
+// Delete takes name of the iPPool and deletes it. Returns an error if one occurs.
+func (c *FakeIPPools) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(ippoolsResource, name), &v1alpha2.IPPool{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeIPPools) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(ippoolsResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.IPPoolList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched iPPool.
+func (c *FakeIPPools) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.IPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(ippoolsResource, name, pt, data, subresources...), &v1alpha2.IPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.IPPool), err
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNetworks implements NetworkInterface
+type FakeNetworks struct {
+	Fake *FakeNetoperatorV1alpha2
+	ns   string
+}
+
+var networksResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "networks"}
+
+var networksKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "Network"}
+
+// Get takes name of the network, and returns the corresponding network object, and an error if there is any.
+func (c *FakeNetworks) Get(name string, options v1.GetOptions) (result *v1alpha2.Network, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(networksResource, c.ns, name), &v1alpha2.Network{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.Network), err
+}
+
+// List takes label and field selectors, and returns the list of Networks that match those selectors.
+func (c *FakeNetworks) List(opts v1.ListOptions) (result *v1alpha2.NetworkList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(networksResource, networksKind, c.ns, opts), &v1alpha2.NetworkList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.NetworkList{ListMeta: obj.(*v1alpha2.NetworkList).ListMeta}
+	for _, item := range obj.(*v1alpha2.NetworkList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested networks.
+func (c *FakeNetworks) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(networksResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a network and creates it.  Returns the server's representation of the network, and an error, if there is any.
+func (c *FakeNetworks) Create(network *v1alpha2.Network) (result *v1alpha2.Network, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(networksResource, c.ns, network), &v1alpha2.Network{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.Network), err
+}
+
+// Update takes the representation of a network and updates it. Returns the server's representation of the network, and an error, if there is any.
+func (c *FakeNetworks) Update(network *v1alpha2.Network) (result *v1alpha2.Network, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(networksResource, c.ns, network), &v1alpha2.Network{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.Network), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNetworks) UpdateStatus(network *v1alpha2.Network) (*v1alpha2.Network, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(networksResource, "status", c.ns, network), &v1alpha2.Network{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.Network), err
+}
+
+// Delete takes name of the network and deletes it. Returns an error if one occurs.
+func (c *FakeNetworks) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(networksResource, c.ns, name), &v1alpha2.Network{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNetworks) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(networksResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.NetworkList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched network.
+func (c *FakeNetworks) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.Network, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(networksResource, c.ns, name, pt, data, subresources...), &v1alpha2.Network{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.Network), err
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNetworkInterfaces implements NetworkInterfaceInterface
+type FakeNetworkInterfaces struct {
+	Fake *FakeNetoperatorV1alpha2
+	ns   string
+}
+
+var networkinterfacesResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "networkinterfaces"}
+
+var networkinterfacesKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "NetworkInterface"}
+
+// Get takes name of the networkInterface, and returns the corresponding networkInterface object, and an error if there is any.
+func (c *FakeNetworkInterfaces) Get(name string, options v1.GetOptions) (result *v1alpha2.NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(networkinterfacesResource, c.ns, name), &v1alpha2.NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NetworkInterface), err
+}
+
+// List takes label and field selectors, and returns the list of NetworkInterfaces that match those selectors.
+func (c *FakeNetworkInterfaces) List(opts v1.ListOptions) (result *v1alpha2.NetworkInterfaceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(networkinterfacesResource, networkinterfacesKind, c.ns, opts), &v1alpha2.NetworkInterfaceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.NetworkInterfaceList{ListMeta: obj.(*v1alpha2.NetworkInterfaceList).ListMeta}
+	for _, item := range obj.(*v1alpha2.NetworkInterfaceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested networkInterfaces.
+func (c *FakeNetworkInterfaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(networkinterfacesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a networkInterface and creates it.  Returns the server's representation of the networkInterface, and an error, if there is any.
+func (c *FakeNetworkInterfaces) Create(networkInterface *v1alpha2.NetworkInterface) (result *v1alpha2.NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(networkinterfacesResource, c.ns, networkInterface), &v1alpha2.NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NetworkInterface), err
+}
+
+// Update takes the representation of a networkInterface and updates it. Returns the server's representation of the networkInterface, and an error, if there is any.
+func (c *FakeNetworkInterfaces) Update(networkInterface *v1alpha2.NetworkInterface) (result *v1alpha2.NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(networkinterfacesResource, c.ns, networkInterface), &v1alpha2.NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NetworkInterface), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNetworkInterfaces) UpdateStatus(networkInterface *v1alpha2.NetworkInterface) (*v1alpha2.NetworkInterface, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(networkinterfacesResource, "status", c.ns, networkInterface), &v1alpha2.NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NetworkInterface), err
+}
+
+// Delete takes name of the networkInterface and deletes it. Returns an error if one occurs.
+func (c *FakeNetworkInterfaces) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(networkinterfacesResource, c.ns, name), &v1alpha2.NetworkInterface{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNetworkInterfaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(networkinterfacesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.NetworkInterfaceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched networkInterface.
+func (c *FakeNetworkInterfaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(networkinterfacesResource, c.ns, name, pt, data, subresources...), &v1alpha2.NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NetworkInterface), err
+}
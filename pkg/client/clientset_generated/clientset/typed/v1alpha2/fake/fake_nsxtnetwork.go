@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNSXTNetworks implements NSXTNetworkInterface
+type FakeNSXTNetworks struct {
+	Fake *FakeNetoperatorV1alpha2
+}
+
+var nsxtnetworksResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "nsxtnetworks"}
+
+var nsxtnetworksKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "NSXTNetwork"}
+
+// Get takes name of the nSXTNetwork, and returns the corresponding nSXTNetwork object, and an error if there is any.
+func (c *FakeNSXTNetworks) Get(name string, options v1.GetOptions) (result *v1alpha2.NSXTNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(nsxtnetworksResource, name), &v1alpha2.NSXTNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NSXTNetwork), err
+}
+
+// List takes label and field selectors, and returns the list of NSXTNetworks that match those selectors.
+func (c *FakeNSXTNetworks) List(opts v1.ListOptions) (result *v1alpha2.NSXTNetworkList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(nsxtnetworksResource, nsxtnetworksKind, opts), &v1alpha2.NSXTNetworkList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.NSXTNetworkList{ListMeta: obj.(*v1alpha2.NSXTNetworkList).ListMeta}
+	for _, item := range obj.(*v1alpha2.NSXTNetworkList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested nSXTNetworks.
+func (c *FakeNSXTNetworks) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(nsxtnetworksResource, opts))
+}
+
+// Create takes the representation of a nSXTNetwork and creates it.  Returns the server's representation of the nSXTNetwork, and an error, if there is any.
+func (c *FakeNSXTNetworks) Create(nSXTNetwork *v1alpha2.NSXTNetwork) (result *v1alpha2.NSXTNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(nsxtnetworksResource, nSXTNetwork), &v1alpha2.NSXTNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NSXTNetwork), err
+}
+
+// Update takes the representation of a nSXTNetwork and updates it. Returns the server's representation of the nSXTNetwork, and an error, if there is any.
+func (c *FakeNSXTNetworks) Update(nSXTNetwork *v1alpha2.NSXTNetwork) (result *v1alpha2.NSXTNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(nsxtnetworksResource, nSXTNetwork), &v1alpha2.NSXTNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NSXTNetwork), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNSXTNetworks) UpdateStatus(nSXTNetwork *v1alpha2.NSXTNetwork) (*v1alpha2.NSXTNetwork, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(nsxtnetworksResource, "status", nSXTNetwork), &v1alpha2.NSXTNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NSXTNetwork), err
+}
+
+// Delete takes name of the nSXTNetwork and deletes it. Returns an error if one occurs.
+func (c *FakeNSXTNetworks) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(nsxtnetworksResource, name), &v1alpha2.NSXTNetwork{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNSXTNetworks) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(nsxtnetworksResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.NSXTNetworkList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched nSXTNetwork.
+func (c *FakeNSXTNetworks) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.NSXTNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(nsxtnetworksResource, name, pt, data, subresources...), &v1alpha2.NSXTNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.NSXTNetwork), err
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSRIOVNetworkInterfaces implements SRIOVNetworkInterfaceInterface
+type FakeSRIOVNetworkInterfaces struct {
+	Fake *FakeNetoperatorV1alpha2
+	ns   string
+}
+
+var sriovnetworkinterfacesResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "sriovnetworkinterfaces"}
+
+var sriovnetworkinterfacesKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "SRIOVNetworkInterface"}
+
+// Get takes name of the sRIOVNetworkInterface, and returns the corresponding sRIOVNetworkInterface object, and an error if there is any.
+func (c *FakeSRIOVNetworkInterfaces) Get(name string, options v1.GetOptions) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(sriovnetworkinterfacesResource, c.ns, name), &v1alpha2.SRIOVNetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.SRIOVNetworkInterface), err
+}
+
+// List takes label and field selectors, and returns the list of SRIOVNetworkInterfaces that match those selectors.
+func (c *FakeSRIOVNetworkInterfaces) List(opts v1.ListOptions) (result *v1alpha2.SRIOVNetworkInterfaceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(sriovnetworkinterfacesResource, sriovnetworkinterfacesKind, c.ns, opts), &v1alpha2.SRIOVNetworkInterfaceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.SRIOVNetworkInterfaceList{ListMeta: obj.(*v1alpha2.SRIOVNetworkInterfaceList).ListMeta}
+	for _, item := range obj.(*v1alpha2.SRIOVNetworkInterfaceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested sRIOVNetworkInterfaces.
+func (c *FakeSRIOVNetworkInterfaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(sriovnetworkinterfacesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a sRIOVNetworkInterface and creates it.  Returns the server's representation of the sRIOVNetworkInterface, and an error, if there is any.
+func (c *FakeSRIOVNetworkInterfaces) Create(sRIOVNetworkInterface *v1alpha2.SRIOVNetworkInterface) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(sriovnetworkinterfacesResource, c.ns, sRIOVNetworkInterface), &v1alpha2.SRIOVNetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.SRIOVNetworkInterface), err
+}
+
+// Update takes the representation of a sRIOVNetworkInterface and updates it. Returns the server's representation of the sRIOVNetworkInterface, and an error, if there is any.
+func (c *FakeSRIOVNetworkInterfaces) Update(sRIOVNetworkInterface *v1alpha2.SRIOVNetworkInterface) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(sriovnetworkinterfacesResource, c.ns, sRIOVNetworkInterface), &v1alpha2.SRIOVNetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.SRIOVNetworkInterface), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSRIOVNetworkInterfaces) UpdateStatus(sRIOVNetworkInterface *v1alpha2.SRIOVNetworkInterface) (*v1alpha2.SRIOVNetworkInterface, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(sriovnetworkinterfacesResource, "status", c.ns, sRIOVNetworkInterface), &v1alpha2.SRIOVNetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.SRIOVNetworkInterface), err
+}
+
+// Delete takes name of the sRIOVNetworkInterface and deletes it. Returns an error if one occurs.
+func (c *FakeSRIOVNetworkInterfaces) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(sriovnetworkinterfacesResource, c.ns, name), &v1alpha2.SRIOVNetworkInterface{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSRIOVNetworkInterfaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(sriovnetworkinterfacesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.SRIOVNetworkInterfaceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched sRIOVNetworkInterface.
+func (c *FakeSRIOVNetworkInterfaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(sriovnetworkinterfacesResource, c.ns, name, pt, data, subresources...), &v1alpha2.SRIOVNetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.SRIOVNetworkInterface), err
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVMXNET3NetworkInterfaces implements VMXNET3NetworkInterfaceInterface
+type FakeVMXNET3NetworkInterfaces struct {
+	Fake *FakeNetoperatorV1alpha2
+	ns   string
+}
+
+var vmxnet3networkinterfacesResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "vmxnet3networkinterfaces"}
+
+var vmxnet3networkinterfacesKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "VMXNET3NetworkInterface"}
+
+// Get takes name of the vMXNET3NetworkInterface, and returns the corresponding vMXNET3NetworkInterface object, and an error if there is any.
+func (c *FakeVMXNET3NetworkInterfaces) Get(name string, options v1.GetOptions) (result *v1alpha2.VMXNET3NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(vmxnet3networkinterfacesResource, c.ns, name), &v1alpha2.VMXNET3NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VMXNET3NetworkInterface), err
+}
+
+// List takes label and field selectors, and returns the list of VMXNET3NetworkInterfaces that match those selectors.
+func (c *FakeVMXNET3NetworkInterfaces) List(opts v1.ListOptions) (result *v1alpha2.VMXNET3NetworkInterfaceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(vmxnet3networkinterfacesResource, vmxnet3networkinterfacesKind, c.ns, opts), &v1alpha2.VMXNET3NetworkInterfaceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.VMXNET3NetworkInterfaceList{ListMeta: obj.(*v1alpha2.VMXNET3NetworkInterfaceList).ListMeta}
+	for _, item := range obj.(*v1alpha2.VMXNET3NetworkInterfaceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested vMXNET3NetworkInterfaces.
+func (c *FakeVMXNET3NetworkInterfaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(vmxnet3networkinterfacesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a vMXNET3NetworkInterface and creates it.  Returns the server's representation of the vMXNET3NetworkInterface, and an error, if there is any.
+func (c *FakeVMXNET3NetworkInterfaces) Create(vMXNET3NetworkInterface *v1alpha2.VMXNET3NetworkInterface) (result *v1alpha2.VMXNET3NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(vmxnet3networkinterfacesResource, c.ns, vMXNET3NetworkInterface), &v1alpha2.VMXNET3NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VMXNET3NetworkInterface), err
+}
+
+// Update takes the representation of a vMXNET3NetworkInterface and updates it. Returns the server's representation of the vMXNET3NetworkInterface, and an error, if there is any.
+func (c *FakeVMXNET3NetworkInterfaces) Update(vMXNET3NetworkInterface *v1alpha2.VMXNET3NetworkInterface) (result *v1alpha2.VMXNET3NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(vmxnet3networkinterfacesResource, c.ns, vMXNET3NetworkInterface), &v1alpha2.VMXNET3NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VMXNET3NetworkInterface), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVMXNET3NetworkInterfaces) UpdateStatus(vMXNET3NetworkInterface *v1alpha2.VMXNET3NetworkInterface) (*v1alpha2.VMXNET3NetworkInterface, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(vmxnet3networkinterfacesResource, "status", c.ns, vMXNET3NetworkInterface), &v1alpha2.VMXNET3NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VMXNET3NetworkInterface), err
+}
+
+// Delete takes name of the vMXNET3NetworkInterface and deletes it. Returns an error if one occurs.
+func (c *FakeVMXNET3NetworkInterfaces) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(vmxnet3networkinterfacesResource, c.ns, name), &v1alpha2.VMXNET3NetworkInterface{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVMXNET3NetworkInterfaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(vmxnet3networkinterfacesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.VMXNET3NetworkInterfaceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched vMXNET3NetworkInterface.
+func (c *FakeVMXNET3NetworkInterfaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.VMXNET3NetworkInterface, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vmxnet3networkinterfacesResource, c.ns, name, pt, data, subresources...), &v1alpha2.VMXNET3NetworkInterface{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VMXNET3NetworkInterface), err
+}
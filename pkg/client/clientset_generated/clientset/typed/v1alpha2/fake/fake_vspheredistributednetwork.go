@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVSphereDistributedNetworks implements VSphereDistributedNetworkInterface
+type FakeVSphereDistributedNetworks struct {
+	Fake *FakeNetoperatorV1alpha2
+}
+
+var vspheredistributednetworksResource = schema.GroupVersionResource{Group: "netoperator.vmware.com", Version: "v1alpha2", Resource: "vspheredistributednetworks"}
+
+var vspheredistributednetworksKind = schema.GroupVersionKind{Group: "netoperator.vmware.com", Version: "v1alpha2", Kind: "VSphereDistributedNetwork"}
+
+// Get takes name of the vSphereDistributedNetwork, and returns the corresponding vSphereDistributedNetwork object, and an error if there is any.
+func (c *FakeVSphereDistributedNetworks) Get(name string, options v1.GetOptions) (result *v1alpha2.VSphereDistributedNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(vspheredistributednetworksResource, name), &v1alpha2.VSphereDistributedNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VSphereDistributedNetwork), err
+}
+
+// List takes label and field selectors, and returns the list of VSphereDistributedNetworks that match those selectors.
+func (c *FakeVSphereDistributedNetworks) List(opts v1.ListOptions) (result *v1alpha2.VSphereDistributedNetworkList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(vspheredistributednetworksResource, vspheredistributednetworksKind, opts), &v1alpha2.VSphereDistributedNetworkList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha2.VSphereDistributedNetworkList{ListMeta: obj.(*v1alpha2.VSphereDistributedNetworkList).ListMeta}
+	for _, item := range obj.(*v1alpha2.VSphereDistributedNetworkList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested vSphereDistributedNetworks.
+func (c *FakeVSphereDistributedNetworks) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(vspheredistributednetworksResource, opts))
+}
+
+// Create takes the representation of a vSphereDistributedNetwork and creates it.  Returns the server's representation of the vSphereDistributedNetwork, and an error, if there is any.
+func (c *FakeVSphereDistributedNetworks) Create(vSphereDistributedNetwork *v1alpha2.VSphereDistributedNetwork) (result *v1alpha2.VSphereDistributedNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(vspheredistributednetworksResource, vSphereDistributedNetwork), &v1alpha2.VSphereDistributedNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VSphereDistributedNetwork), err
+}
+
+// Update takes the representation of a vSphereDistributedNetwork and updates it. Returns the server's representation of the vSphereDistributedNetwork, and an error, if there is any.
+func (c *FakeVSphereDistributedNetworks) Update(vSphereDistributedNetwork *v1alpha2.VSphereDistributedNetwork) (result *v1alpha2.VSphereDistributedNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(vspheredistributednetworksResource, vSphereDistributedNetwork), &v1alpha2.VSphereDistributedNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VSphereDistributedNetwork), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVSphereDistributedNetworks) UpdateStatus(vSphereDistributedNetwork *v1alpha2.VSphereDistributedNetwork) (*v1alpha2.VSphereDistributedNetwork, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(vspheredistributednetworksResource, "status", vSphereDistributedNetwork), &v1alpha2.VSphereDistributedNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VSphereDistributedNetwork), err
+}
+
+// Delete takes name of the vSphereDistributedNetwork and deletes it. Returns an error if one occurs.
+func (c *FakeVSphereDistributedNetworks) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(vspheredistributednetworksResource, name), &v1alpha2.VSphereDistributedNetwork{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVSphereDistributedNetworks) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(vspheredistributednetworksResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha2.VSphereDistributedNetworkList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched vSphereDistributedNetwork.
+func (c *FakeVSphereDistributedNetworks) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.VSphereDistributedNetwork, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(vspheredistributednetworksResource, name, pt, data, subresources...), &v1alpha2.VSphereDistributedNetwork{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VSphereDistributedNetwork), err
+}
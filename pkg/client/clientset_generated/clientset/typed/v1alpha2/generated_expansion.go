@@ -0,0 +1,28 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+type AviLoadBalancerConfigExpansion interface{}
+
+type E1000ENetworkInterfaceExpansion interface{}
+
+type HAProxyLoadBalancerConfigExpansion interface{}
+
+type IPPoolExpansion interface{}
+
+type LoadBalancerConfigExpansion interface{}
+
+type NSXTNetworkExpansion interface{}
+
+type NetworkExpansion interface{}
+
+type NetworkInterfaceExpansion interface{}
+
+type SRIOVNetworkInterfaceExpansion interface{}
+
+type VMXNET3NetworkInterfaceExpansion interface{}
+
+type VSphereDistributedNetworkExpansion interface{}
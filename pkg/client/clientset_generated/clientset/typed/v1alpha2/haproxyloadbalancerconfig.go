@@ -0,0 +1,167 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// HAProxyLoadBalancerConfigsGetter has a method to return a HAProxyLoadBalancerConfigInterface.
+// A group's client should implement this interface.
+type HAProxyLoadBalancerConfigsGetter interface {
+	HAProxyLoadBalancerConfigs() HAProxyLoadBalancerConfigInterface
+}
+
+// HAProxyLoadBalancerConfigInterface has methods to work with HAProxyLoadBalancerConfig resources.
+type HAProxyLoadBalancerConfigInterface interface {
+	Create(*v1alpha2.HAProxyLoadBalancerConfig) (*v1alpha2.HAProxyLoadBalancerConfig, error)
+	Update(*v1alpha2.HAProxyLoadBalancerConfig) (*v1alpha2.HAProxyLoadBalancerConfig, error)
+	UpdateStatus(*v1alpha2.HAProxyLoadBalancerConfig) (*v1alpha2.HAProxyLoadBalancerConfig, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.HAProxyLoadBalancerConfig, error)
+	List(opts v1.ListOptions) (*v1alpha2.HAProxyLoadBalancerConfigList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.HAProxyLoadBalancerConfig, err error)
+	HAProxyLoadBalancerConfigExpansion
+}
+
+// hAProxyLoadBalancerConfigs implements HAProxyLoadBalancerConfigInterface
+type hAProxyLoadBalancerConfigs struct {
+	client rest.Interface
+}
+
+// newHAProxyLoadBalancerConfigs returns a HAProxyLoadBalancerConfigs
+func newHAProxyLoadBalancerConfigs(c *NetoperatorV1alpha2Client) *hAProxyLoadBalancerConfigs {
+	return &hAProxyLoadBalancerConfigs{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the hAProxyLoadBalancerConfig, and returns the corresponding hAProxyLoadBalancerConfig object, and an error if there is any.
+func (c *hAProxyLoadBalancerConfigs) Get(name string, options v1.GetOptions) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	result = &v1alpha2.HAProxyLoadBalancerConfig{}
+	err = c.client.Get().
+		Resource("haproxyloadbalancerconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of HAProxyLoadBalancerConfigs that match those selectors.
+func (c *hAProxyLoadBalancerConfigs) List(opts v1.ListOptions) (result *v1alpha2.HAProxyLoadBalancerConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.HAProxyLoadBalancerConfigList{}
+	err = c.client.Get().
+		Resource("haproxyloadbalancerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested hAProxyLoadBalancerConfigs.
+func (c *hAProxyLoadBalancerConfigs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("haproxyloadbalancerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a hAProxyLoadBalancerConfig and creates it.  Returns the server's representation of the hAProxyLoadBalancerConfig, and an error, if there is any.
+func (c *hAProxyLoadBalancerConfigs) Create(hAProxyLoadBalancerConfig *v1alpha2.HAProxyLoadBalancerConfig) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	result = &v1alpha2.HAProxyLoadBalancerConfig{}
+	err = c.client.Post().
+		Resource("haproxyloadbalancerconfigs").
+		Body(hAProxyLoadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a hAProxyLoadBalancerConfig and updates it. Returns the server's representation of the hAProxyLoadBalancerConfig, and an error, if there is any.
+func (c *hAProxyLoadBalancerConfigs) Update(hAProxyLoadBalancerConfig *v1alpha2.HAProxyLoadBalancerConfig) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	result = &v1alpha2.HAProxyLoadBalancerConfig{}
+	err = c.client.Put().
+		Resource("haproxyloadbalancerconfigs").
+		Name(hAProxyLoadBalancerConfig.Name).
+		Body(hAProxyLoadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *hAProxyLoadBalancerConfigs) UpdateStatus(hAProxyLoadBalancerConfig *v1alpha2.HAProxyLoadBalancerConfig) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	result = &v1alpha2.HAProxyLoadBalancerConfig{}
+	err = c.client.Put().
+		Resource("haproxyloadbalancerconfigs").
+		Name(hAProxyLoadBalancerConfig.Name).
+		SubResource("status").
+		Body(hAProxyLoadBalancerConfig).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the hAProxyLoadBalancerConfig and deletes it. Returns an error if one occurs.
+func (c *hAProxyLoadBalancerConfigs) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("haproxyloadbalancerconfigs").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *hAProxyLoadBalancerConfigs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("haproxyloadbalancerconfigs").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched hAProxyLoadBalancerConfig.
+func (c *hAProxyLoadBalancerConfigs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.HAProxyLoadBalancerConfig, err error) {
+	result = &v1alpha2.HAProxyLoadBalancerConfig{}
+	err = c.client.Patch(pt).
+		Resource("haproxyloadbalancerconfigs").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
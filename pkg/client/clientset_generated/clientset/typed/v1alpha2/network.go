@@ -0,0 +1,178 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NetworksGetter has a method to return a NetworkInterface.
+// A group's client should implement this interface.
+type NetworksGetter interface {
+	Networks(namespace string) NetworkInterface
+}
+
+// NetworkInterface has methods to work with Network resources.
+type NetworkInterface interface {
+	Create(*v1alpha2.Network) (*v1alpha2.Network, error)
+	Update(*v1alpha2.Network) (*v1alpha2.Network, error)
+	UpdateStatus(*v1alpha2.Network) (*v1alpha2.Network, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.Network, error)
+	List(opts v1.ListOptions) (*v1alpha2.NetworkList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.Network, err error)
+	NetworkExpansion
+}
+
+// networks implements NetworkInterface
+type networks struct {
+	client rest.Interface
+	ns     string
+}
+
+// newNetworks returns a Networks
+func newNetworks(c *NetoperatorV1alpha2Client, namespace string) *networks {
+	return &networks{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the network, and returns the corresponding network object, and an error if there is any.
+func (c *networks) Get(name string, options v1.GetOptions) (result *v1alpha2.Network, err error) {
+	result = &v1alpha2.Network{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("networks").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Networks that match those selectors.
+func (c *networks) List(opts v1.ListOptions) (result *v1alpha2.NetworkList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.NetworkList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("networks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested networks.
+func (c *networks) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("networks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a network and creates it.  Returns the server's representation of the network, and an error, if there is any.
+func (c *networks) Create(network *v1alpha2.Network) (result *v1alpha2.Network, err error) {
+	result = &v1alpha2.Network{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("networks").
+		Body(network).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a network and updates it. Returns the server's representation of the network, and an error, if there is any.
+func (c *networks) Update(network *v1alpha2.Network) (result *v1alpha2.Network, err error) {
+	result = &v1alpha2.Network{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("networks").
+		Name(network.Name).
+		Body(network).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *networks) UpdateStatus(network *v1alpha2.Network) (result *v1alpha2.Network, err error) {
+	result = &v1alpha2.Network{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("networks").
+		Name(network.Name).
+		SubResource("status").
+		Body(network).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the network and deletes it. Returns an error if one occurs.
+func (c *networks) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("networks").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *networks) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("networks").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched network.
+func (c *networks) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.Network, err error) {
+	result = &v1alpha2.Network{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("networks").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
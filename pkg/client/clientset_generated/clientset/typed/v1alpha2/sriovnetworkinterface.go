@@ -0,0 +1,178 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// SRIOVNetworkInterfacesGetter has a method to return a SRIOVNetworkInterfaceInterface.
+// A group's client should implement this interface.
+type SRIOVNetworkInterfacesGetter interface {
+	SRIOVNetworkInterfaces(namespace string) SRIOVNetworkInterfaceInterface
+}
+
+// SRIOVNetworkInterfaceInterface has methods to work with SRIOVNetworkInterface resources.
+type SRIOVNetworkInterfaceInterface interface {
+	Create(*v1alpha2.SRIOVNetworkInterface) (*v1alpha2.SRIOVNetworkInterface, error)
+	Update(*v1alpha2.SRIOVNetworkInterface) (*v1alpha2.SRIOVNetworkInterface, error)
+	UpdateStatus(*v1alpha2.SRIOVNetworkInterface) (*v1alpha2.SRIOVNetworkInterface, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.SRIOVNetworkInterface, error)
+	List(opts v1.ListOptions) (*v1alpha2.SRIOVNetworkInterfaceList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.SRIOVNetworkInterface, err error)
+	SRIOVNetworkInterfaceExpansion
+}
+
+// sRIOVNetworkInterfaces implements SRIOVNetworkInterfaceInterface
+type sRIOVNetworkInterfaces struct {
+	client rest.Interface
+	ns     string
+}
+
+// newSRIOVNetworkInterfaces returns a SRIOVNetworkInterfaces
+func newSRIOVNetworkInterfaces(c *NetoperatorV1alpha2Client, namespace string) *sRIOVNetworkInterfaces {
+	return &sRIOVNetworkInterfaces{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the sRIOVNetworkInterface, and returns the corresponding sRIOVNetworkInterface object, and an error if there is any.
+func (c *sRIOVNetworkInterfaces) Get(name string, options v1.GetOptions) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	result = &v1alpha2.SRIOVNetworkInterface{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of SRIOVNetworkInterfaces that match those selectors.
+func (c *sRIOVNetworkInterfaces) List(opts v1.ListOptions) (result *v1alpha2.SRIOVNetworkInterfaceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.SRIOVNetworkInterfaceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested sRIOVNetworkInterfaces.
+func (c *sRIOVNetworkInterfaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a sRIOVNetworkInterface and creates it.  Returns the server's representation of the sRIOVNetworkInterface, and an error, if there is any.
+func (c *sRIOVNetworkInterfaces) Create(sRIOVNetworkInterface *v1alpha2.SRIOVNetworkInterface) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	result = &v1alpha2.SRIOVNetworkInterface{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		Body(sRIOVNetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a sRIOVNetworkInterface and updates it. Returns the server's representation of the sRIOVNetworkInterface, and an error, if there is any.
+func (c *sRIOVNetworkInterfaces) Update(sRIOVNetworkInterface *v1alpha2.SRIOVNetworkInterface) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	result = &v1alpha2.SRIOVNetworkInterface{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		Name(sRIOVNetworkInterface.Name).
+		Body(sRIOVNetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *sRIOVNetworkInterfaces) UpdateStatus(sRIOVNetworkInterface *v1alpha2.SRIOVNetworkInterface) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	result = &v1alpha2.SRIOVNetworkInterface{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		Name(sRIOVNetworkInterface.Name).
+		SubResource("status").
+		Body(sRIOVNetworkInterface).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the sRIOVNetworkInterface and deletes it. Returns an error if one occurs.
+func (c *sRIOVNetworkInterfaces) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *sRIOVNetworkInterfaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched sRIOVNetworkInterface.
+func (c *sRIOVNetworkInterfaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.SRIOVNetworkInterface, err error) {
+	result = &v1alpha2.SRIOVNetworkInterface{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("sriovnetworkinterfaces").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	clientset "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+	internalinterfaces "github.com/vmware-tanzu/net-operator-api/pkg/client/informers_generated/internalinterfaces"
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/listers_generated/core/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// E1000ENetworkInterfaceInformer provides access to a shared informer and lister for
+// E1000ENetworkInterfaces.
+type E1000ENetworkInterfaceInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.E1000ENetworkInterfaceLister
+}
+
+type e1000ENetworkInterfaceInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewE1000ENetworkInterfaceInformer constructs a new informer for E1000ENetworkInterface type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewE1000ENetworkInterfaceInformer(client clientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredE1000ENetworkInterfaceInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredE1000ENetworkInterfaceInformer constructs a new informer for E1000ENetworkInterface type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredE1000ENetworkInterfaceInformer(client clientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().E1000ENetworkInterfaces(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().E1000ENetworkInterfaces(namespace).Watch(options)
+			},
+		},
+		&apiv1alpha1.E1000ENetworkInterface{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *e1000ENetworkInterfaceInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredE1000ENetworkInterfaceInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *e1000ENetworkInterfaceInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apiv1alpha1.E1000ENetworkInterface{}, f.defaultInformer)
+}
+
+func (f *e1000ENetworkInterfaceInformer) Lister() v1alpha1.E1000ENetworkInterfaceLister {
+	return v1alpha1.NewE1000ENetworkInterfaceLister(f.Informer().GetIndexer())
+}
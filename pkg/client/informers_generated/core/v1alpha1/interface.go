@@ -0,0 +1,102 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/vmware-tanzu/net-operator-api/pkg/client/informers_generated/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// AviLoadBalancerConfigs returns a AviLoadBalancerConfigInformer.
+	AviLoadBalancerConfigs() AviLoadBalancerConfigInformer
+	// E1000ENetworkInterfaces returns a E1000ENetworkInterfaceInformer.
+	E1000ENetworkInterfaces() E1000ENetworkInterfaceInformer
+	// HAProxyLoadBalancerConfigs returns a HAProxyLoadBalancerConfigInformer.
+	HAProxyLoadBalancerConfigs() HAProxyLoadBalancerConfigInformer
+	// IPPools returns a IPPoolInformer.
+	IPPools() IPPoolInformer
+	// LoadBalancerConfigs returns a LoadBalancerConfigInformer.
+	LoadBalancerConfigs() LoadBalancerConfigInformer
+	// NSXTNetworks returns a NSXTNetworkInformer.
+	NSXTNetworks() NSXTNetworkInformer
+	// Networks returns a NetworkInformer.
+	Networks() NetworkInformer
+	// NetworkInterfaces returns a NetworkInterfaceInformer.
+	NetworkInterfaces() NetworkInterfaceInformer
+	// SRIOVNetworkInterfaces returns a SRIOVNetworkInterfaceInformer.
+	SRIOVNetworkInterfaces() SRIOVNetworkInterfaceInformer
+	// VMXNET3NetworkInterfaces returns a VMXNET3NetworkInterfaceInformer.
+	VMXNET3NetworkInterfaces() VMXNET3NetworkInterfaceInformer
+	// VSphereDistributedNetworks returns a VSphereDistributedNetworkInformer.
+	VSphereDistributedNetworks() VSphereDistributedNetworkInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// AviLoadBalancerConfigs returns a AviLoadBalancerConfigInformer.
+func (v *version) AviLoadBalancerConfigs() AviLoadBalancerConfigInformer {
+	return &aviLoadBalancerConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// E1000ENetworkInterfaces returns a E1000ENetworkInterfaceInformer.
+func (v *version) E1000ENetworkInterfaces() E1000ENetworkInterfaceInformer {
+	return &e1000ENetworkInterfaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// HAProxyLoadBalancerConfigs returns a HAProxyLoadBalancerConfigInformer.
+func (v *version) HAProxyLoadBalancerConfigs() HAProxyLoadBalancerConfigInformer {
+	return &hAProxyLoadBalancerConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// IPPools returns a IPPoolInformer.
+func (v *version) IPPools() IPPoolInformer {
+	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// LoadBalancerConfigs returns a LoadBalancerConfigInformer.
+func (v *version) LoadBalancerConfigs() LoadBalancerConfigInformer {
+	return &loadBalancerConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// NSXTNetworks returns a NSXTNetworkInformer.
+func (v *version) NSXTNetworks() NSXTNetworkInformer {
+	return &nSXTNetworkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Networks returns a NetworkInformer.
+func (v *version) Networks() NetworkInformer {
+	return &networkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// NetworkInterfaces returns a NetworkInterfaceInformer.
+func (v *version) NetworkInterfaces() NetworkInterfaceInformer {
+	return &networkInterfaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SRIOVNetworkInterfaces returns a SRIOVNetworkInterfaceInformer.
+func (v *version) SRIOVNetworkInterfaces() SRIOVNetworkInterfaceInformer {
+	return &sRIOVNetworkInterfaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VMXNET3NetworkInterfaces returns a VMXNET3NetworkInterfaceInformer.
+func (v *version) VMXNET3NetworkInterfaces() VMXNET3NetworkInterfaceInformer {
+	return &vMXNET3NetworkInterfaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VSphereDistributedNetworks returns a VSphereDistributedNetworkInformer.
+func (v *version) VSphereDistributedNetworks() VSphereDistributedNetworkInformer {
+	return &vSphereDistributedNetworkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AviLoadBalancerConfigListerExpansion allows custom methods to be added to
+// AviLoadBalancerConfigLister.
+type AviLoadBalancerConfigListerExpansion interface{}
+
+// E1000ENetworkInterfaceListerExpansion allows custom methods to be added to
+// E1000ENetworkInterfaceLister.
+type E1000ENetworkInterfaceListerExpansion interface{}
+
+// E1000ENetworkInterfaceNamespaceListerExpansion allows custom methods to be added to
+// E1000ENetworkInterfaceNamespaceLister.
+type E1000ENetworkInterfaceNamespaceListerExpansion interface{}
+
+// HAProxyLoadBalancerConfigListerExpansion allows custom methods to be added to
+// HAProxyLoadBalancerConfigLister.
+type HAProxyLoadBalancerConfigListerExpansion interface{}
+
+// IPPoolListerExpansion allows custom methods to be added to
+// IPPoolLister.
+type IPPoolListerExpansion interface{}
+
+// LoadBalancerConfigListerExpansion allows custom methods to be added to
+// LoadBalancerConfigLister.
+type LoadBalancerConfigListerExpansion interface{}
+
+// NSXTNetworkListerExpansion allows custom methods to be added to
+// NSXTNetworkLister.
+type NSXTNetworkListerExpansion interface{}
+
+// NetworkListerExpansion allows custom methods to be added to
+// NetworkLister.
+type NetworkListerExpansion interface{}
+
+// NetworkNamespaceListerExpansion allows custom methods to be added to
+// NetworkNamespaceLister.
+type NetworkNamespaceListerExpansion interface{}
+
+// NetworkInterfaceListerExpansion allows custom methods to be added to
+// NetworkInterfaceLister.
+type NetworkInterfaceListerExpansion interface{}
+
+// NetworkInterfaceNamespaceListerExpansion allows custom methods to be added to
+// NetworkInterfaceNamespaceLister.
+type NetworkInterfaceNamespaceListerExpansion interface{}
+
+// SRIOVNetworkInterfaceListerExpansion allows custom methods to be added to
+// SRIOVNetworkInterfaceLister.
+type SRIOVNetworkInterfaceListerExpansion interface{}
+
+// SRIOVNetworkInterfaceNamespaceListerExpansion allows custom methods to be added to
+// SRIOVNetworkInterfaceNamespaceLister.
+type SRIOVNetworkInterfaceNamespaceListerExpansion interface{}
+
+// VMXNET3NetworkInterfaceListerExpansion allows custom methods to be added to
+// VMXNET3NetworkInterfaceLister.
+type VMXNET3NetworkInterfaceListerExpansion interface{}
+
+// VMXNET3NetworkInterfaceNamespaceListerExpansion allows custom methods to be added to
+// VMXNET3NetworkInterfaceNamespaceLister.
+type VMXNET3NetworkInterfaceNamespaceListerExpansion interface{}
+
+// VSphereDistributedNetworkListerExpansion allows custom methods to be added to
+// VSphereDistributedNetworkLister.
+type VSphereDistributedNetworkListerExpansion interface{}
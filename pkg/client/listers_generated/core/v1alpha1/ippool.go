@@ -0,0 +1,52 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IPPoolLister helps list IPPools.
+type IPPoolLister interface {
+	// List lists all IPPools in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.IPPool, err error)
+	// Get retrieves the IPPool from the index for a given name.
+	Get(name string) (*v1alpha1.IPPool, error)
+	IPPoolListerExpansion
+}
+
+// iPPoolLister implements the IPPoolLister interface.
+type iPPoolLister struct {
+	indexer cache.Indexer
+}
+
+// NewIPPoolLister returns a new IPPoolLister.
+func NewIPPoolLister(indexer cache.Indexer) IPPoolLister {
+	return &iPPoolLister{indexer: indexer}
+}
+
+// List lists all IPPools in the indexer.
+func (s *iPPoolLister) List(selector labels.Selector) (ret []*v1alpha1.IPPool, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.IPPool))
+	})
+	return ret, err
+}
+
+// Get retrieves the IPPool from the index for a given name.
+func (s *iPPoolLister) Get(name string) (*v1alpha1.IPPool, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("ippool"), name)
+	}
+	return obj.(*v1alpha1.IPPool), nil
+}
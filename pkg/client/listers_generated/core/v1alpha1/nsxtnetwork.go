@@ -0,0 +1,52 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NSXTNetworkLister helps list NSXTNetworks.
+type NSXTNetworkLister interface {
+	// List lists all NSXTNetworks in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.NSXTNetwork, err error)
+	// Get retrieves the NSXTNetwork from the index for a given name.
+	Get(name string) (*v1alpha1.NSXTNetwork, error)
+	NSXTNetworkListerExpansion
+}
+
+// nSXTNetworkLister implements the NSXTNetworkLister interface.
+type nSXTNetworkLister struct {
+	indexer cache.Indexer
+}
+
+// NewNSXTNetworkLister returns a new NSXTNetworkLister.
+func NewNSXTNetworkLister(indexer cache.Indexer) NSXTNetworkLister {
+	return &nSXTNetworkLister{indexer: indexer}
+}
+
+// List lists all NSXTNetworks in the indexer.
+func (s *nSXTNetworkLister) List(selector labels.Selector) (ret []*v1alpha1.NSXTNetwork, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.NSXTNetwork))
+	})
+	return ret, err
+}
+
+// Get retrieves the NSXTNetwork from the index for a given name.
+func (s *nSXTNetworkLister) Get(name string) (*v1alpha1.NSXTNetwork, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("nsxtnetwork"), name)
+	}
+	return obj.(*v1alpha1.NSXTNetwork), nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AviLoadBalancerConfigLister helps list AviLoadBalancerConfigs.
+type AviLoadBalancerConfigLister interface {
+	// List lists all AviLoadBalancerConfigs in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha2.AviLoadBalancerConfig, err error)
+	// Get retrieves the AviLoadBalancerConfig from the index for a given name.
+	Get(name string) (*v1alpha2.AviLoadBalancerConfig, error)
+	AviLoadBalancerConfigListerExpansion
+}
+
+// aviLoadBalancerConfigLister implements the AviLoadBalancerConfigLister interface.
+type aviLoadBalancerConfigLister struct {
+	indexer cache.Indexer
+}
+
+// NewAviLoadBalancerConfigLister returns a new AviLoadBalancerConfigLister.
+func NewAviLoadBalancerConfigLister(indexer cache.Indexer) AviLoadBalancerConfigLister {
+	return &aviLoadBalancerConfigLister{indexer: indexer}
+}
+
+// List lists all AviLoadBalancerConfigs in the indexer.
+func (s *aviLoadBalancerConfigLister) List(selector labels.Selector) (ret []*v1alpha2.AviLoadBalancerConfig, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.AviLoadBalancerConfig))
+	})
+	return ret, err
+}
+
+// Get retrieves the AviLoadBalancerConfig from the index for a given name.
+func (s *aviLoadBalancerConfigLister) Get(name string) (*v1alpha2.AviLoadBalancerConfig, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha2.Resource("aviloadbalancerconfig"), name)
+	}
+	return obj.(*v1alpha2.AviLoadBalancerConfig), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// E1000ENetworkInterfaceLister helps list E1000ENetworkInterfaces.
+type E1000ENetworkInterfaceLister interface {
+	// List lists all E1000ENetworkInterfaces in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha2.E1000ENetworkInterface, err error)
+	// E1000ENetworkInterfaces returns an object that can list and get E1000ENetworkInterfaces.
+	E1000ENetworkInterfaces(namespace string) E1000ENetworkInterfaceNamespaceLister
+	E1000ENetworkInterfaceListerExpansion
+}
+
+// e1000ENetworkInterfaceLister implements the E1000ENetworkInterfaceLister interface.
+type e1000ENetworkInterfaceLister struct {
+	indexer cache.Indexer
+}
+
+// NewE1000ENetworkInterfaceLister returns a new E1000ENetworkInterfaceLister.
+func NewE1000ENetworkInterfaceLister(indexer cache.Indexer) E1000ENetworkInterfaceLister {
+	return &e1000ENetworkInterfaceLister{indexer: indexer}
+}
+
+// List lists all E1000ENetworkInterfaces in the indexer.
+func (s *e1000ENetworkInterfaceLister) List(selector labels.Selector) (ret []*v1alpha2.E1000ENetworkInterface, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.E1000ENetworkInterface))
+	})
+	return ret, err
+}
+
+// E1000ENetworkInterfaces returns an object that can list and get E1000ENetworkInterfaces.
+func (s *e1000ENetworkInterfaceLister) E1000ENetworkInterfaces(namespace string) E1000ENetworkInterfaceNamespaceLister {
+	return e1000ENetworkInterfaceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// E1000ENetworkInterfaceNamespaceLister helps list and get E1000ENetworkInterfaces.
+type E1000ENetworkInterfaceNamespaceLister interface {
+	// List lists all E1000ENetworkInterfaces in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha2.E1000ENetworkInterface, err error)
+	// Get retrieves the E1000ENetworkInterface from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha2.E1000ENetworkInterface, error)
+	E1000ENetworkInterfaceNamespaceListerExpansion
+}
+
+// e1000ENetworkInterfaceNamespaceLister implements the E1000ENetworkInterfaceNamespaceLister
+// interface.
+type e1000ENetworkInterfaceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all E1000ENetworkInterfaces in the indexer for a given namespace.
+func (s e1000ENetworkInterfaceNamespaceLister) List(selector labels.Selector) (ret []*v1alpha2.E1000ENetworkInterface, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.E1000ENetworkInterface))
+	})
+	return ret, err
+}
+
+// Get retrieves the E1000ENetworkInterface from the indexer for a given namespace and name.
+func (s e1000ENetworkInterfaceNamespaceLister) Get(name string) (*v1alpha2.E1000ENetworkInterface, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha2.Resource("e1000enetworkinterface"), name)
+	}
+	return obj.(*v1alpha2.E1000ENetworkInterface), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SRIOVNetworkInterfaceLister helps list SRIOVNetworkInterfaces.
+type SRIOVNetworkInterfaceLister interface {
+	// List lists all SRIOVNetworkInterfaces in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha2.SRIOVNetworkInterface, err error)
+	// SRIOVNetworkInterfaces returns an object that can list and get SRIOVNetworkInterfaces.
+	SRIOVNetworkInterfaces(namespace string) SRIOVNetworkInterfaceNamespaceLister
+	SRIOVNetworkInterfaceListerExpansion
+}
+
+// sRIOVNetworkInterfaceLister implements the SRIOVNetworkInterfaceLister interface.
+type sRIOVNetworkInterfaceLister struct {
+	indexer cache.Indexer
+}
+
+// NewSRIOVNetworkInterfaceLister returns a new SRIOVNetworkInterfaceLister.
+func NewSRIOVNetworkInterfaceLister(indexer cache.Indexer) SRIOVNetworkInterfaceLister {
+	return &sRIOVNetworkInterfaceLister{indexer: indexer}
+}
+
+// List lists all SRIOVNetworkInterfaces in the indexer.
+func (s *sRIOVNetworkInterfaceLister) List(selector labels.Selector) (ret []*v1alpha2.SRIOVNetworkInterface, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.SRIOVNetworkInterface))
+	})
+	return ret, err
+}
+
+// SRIOVNetworkInterfaces returns an object that can list and get SRIOVNetworkInterfaces.
+func (s *sRIOVNetworkInterfaceLister) SRIOVNetworkInterfaces(namespace string) SRIOVNetworkInterfaceNamespaceLister {
+	return sRIOVNetworkInterfaceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// SRIOVNetworkInterfaceNamespaceLister helps list and get SRIOVNetworkInterfaces.
+type SRIOVNetworkInterfaceNamespaceLister interface {
+	// List lists all SRIOVNetworkInterfaces in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha2.SRIOVNetworkInterface, err error)
+	// Get retrieves the SRIOVNetworkInterface from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha2.SRIOVNetworkInterface, error)
+	SRIOVNetworkInterfaceNamespaceListerExpansion
+}
+
+// sRIOVNetworkInterfaceNamespaceLister implements the SRIOVNetworkInterfaceNamespaceLister
+// interface.
+type sRIOVNetworkInterfaceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all SRIOVNetworkInterfaces in the indexer for a given namespace.
+func (s sRIOVNetworkInterfaceNamespaceLister) List(selector labels.Selector) (ret []*v1alpha2.SRIOVNetworkInterface, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.SRIOVNetworkInterface))
+	})
+	return ret, err
+}
+
+// Get retrieves the SRIOVNetworkInterface from the indexer for a given namespace and name.
+func (s sRIOVNetworkInterfaceNamespaceLister) Get(name string) (*v1alpha2.SRIOVNetworkInterface, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha2.Resource("sriovnetworkinterface"), name)
+	}
+	return obj.(*v1alpha2.SRIOVNetworkInterface), nil
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// For returns a Setter for the conditions of the given object. An error is
+// returned if the kind of the object does not have conditions.
+func For(obj runtime.Object) (Setter, error) {
+	switch o := obj.(type) {
+	case *v1alpha1.IPPool:
+		return ipPoolV1alpha1{o}, nil
+	case *v1alpha1.LoadBalancerConfig:
+		return loadBalancerConfigV1alpha1{o}, nil
+	case *v1alpha1.NetworkInterface:
+		return networkInterfaceV1alpha1{o}, nil
+	case *v1alpha1.NSXTNetwork:
+		return nsxtNetworkV1alpha1{o}, nil
+	case *v1alpha1.VSphereDistributedNetwork:
+		return vsphereDistributedNetworkV1alpha1{o}, nil
+	case *v1alpha2.IPPool:
+		return ipPoolV1alpha2{o}, nil
+	case *v1alpha2.LoadBalancerConfig:
+		return loadBalancerConfigV1alpha2{o}, nil
+	case *v1alpha2.NetworkInterface:
+		return networkInterfaceV1alpha2{o}, nil
+	case *v1alpha2.NSXTNetwork:
+		return nsxtNetworkV1alpha2{o}, nil
+	case *v1alpha2.VSphereDistributedNetwork:
+		return vsphereDistributedNetworkV1alpha2{o}, nil
+	default:
+		return nil, fmt.Errorf("%T does not have conditions", obj)
+	}
+}
+
+type ipPoolV1alpha1 struct {
+	*v1alpha1.IPPool
+}
+
+func (o ipPoolV1alpha1) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:    string(c.Type),
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	return conditions
+}
+
+func (o ipPoolV1alpha1) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha1.IPPoolCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha1.IPPoolCondition{
+			Type:    v1alpha1.IPPoolConditionType(c.Type),
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+}
+
+type loadBalancerConfigV1alpha1 struct {
+	*v1alpha1.LoadBalancerConfig
+}
+
+func (o loadBalancerConfigV1alpha1) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o loadBalancerConfigV1alpha1) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha1.LoadBalancerConfigCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha1.LoadBalancerConfigCondition{
+			Type:               v1alpha1.LoadBalancerConfigConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
+
+type networkInterfaceV1alpha1 struct {
+	*v1alpha1.NetworkInterface
+}
+
+func (o networkInterfaceV1alpha1) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             string(c.Reason),
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o networkInterfaceV1alpha1) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha1.NetworkInterfaceCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha1.NetworkInterfaceCondition{
+			Type:               v1alpha1.NetworkInterfaceConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             v1alpha1.NetworkInterfaceConditionReason(c.Reason),
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
+
+type nsxtNetworkV1alpha1 struct {
+	*v1alpha1.NSXTNetwork
+}
+
+func (o nsxtNetworkV1alpha1) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o nsxtNetworkV1alpha1) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha1.NSXTNetworkCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha1.NSXTNetworkCondition{
+			Type:               v1alpha1.NSXTNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
+
+type vsphereDistributedNetworkV1alpha1 struct {
+	*v1alpha1.VSphereDistributedNetwork
+}
+
+func (o vsphereDistributedNetworkV1alpha1) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o vsphereDistributedNetworkV1alpha1) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha1.VSphereDistributedNetworkCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha1.VSphereDistributedNetworkCondition{
+			Type:               v1alpha1.VSphereDistributedNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
+
+type ipPoolV1alpha2 struct {
+	*v1alpha2.IPPool
+}
+
+func (o ipPoolV1alpha2) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:    string(c.Type),
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	return conditions
+}
+
+func (o ipPoolV1alpha2) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha2.IPPoolCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha2.IPPoolCondition{
+			Type:    v1alpha2.IPPoolConditionType(c.Type),
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+}
+
+type loadBalancerConfigV1alpha2 struct {
+	*v1alpha2.LoadBalancerConfig
+}
+
+func (o loadBalancerConfigV1alpha2) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o loadBalancerConfigV1alpha2) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha2.LoadBalancerConfigCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha2.LoadBalancerConfigCondition{
+			Type:               v1alpha2.LoadBalancerConfigConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
+
+type networkInterfaceV1alpha2 struct {
+	*v1alpha2.NetworkInterface
+}
+
+func (o networkInterfaceV1alpha2) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             string(c.Reason),
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o networkInterfaceV1alpha2) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha2.NetworkInterfaceCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha2.NetworkInterfaceCondition{
+			Type:               v1alpha2.NetworkInterfaceConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             v1alpha2.NetworkInterfaceConditionReason(c.Reason),
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
+
+type nsxtNetworkV1alpha2 struct {
+	*v1alpha2.NSXTNetwork
+}
+
+func (o nsxtNetworkV1alpha2) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o nsxtNetworkV1alpha2) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha2.NSXTNetworkCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha2.NSXTNetworkCondition{
+			Type:               v1alpha2.NSXTNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
+
+type vsphereDistributedNetworkV1alpha2 struct {
+	*v1alpha2.VSphereDistributedNetwork
+}
+
+func (o vsphereDistributedNetworkV1alpha2) GetConditions() []Condition {
+	conditions := make([]Condition, 0, len(o.Status.Conditions))
+	for _, c := range o.Status.Conditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func (o vsphereDistributedNetworkV1alpha2) SetConditions(conditions []Condition) {
+	o.Status.Conditions = make([]v1alpha2.VSphereDistributedNetworkCondition, 0, len(conditions))
+	for _, c := range conditions {
+		o.Status.Conditions = append(o.Status.Conditions, v1alpha2.VSphereDistributedNetworkCondition{
+			Type:               v1alpha2.VSphereDistributedNetworkConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conditions provides helpers for reading and writing the conditions
+// of netoperator.vmware.com objects. Every kind has its own condition type, so
+// the helpers operate on a kind independent Condition through the Getter and
+// Setter interfaces. Use For to obtain a Setter for an object.
+package conditions
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition is a kind independent representation of the condition of an
+// object.
+type Condition struct {
+	// Type is the type of the condition.
+	Type string
+	// Status is the status of the condition, one of True, False or Unknown.
+	Status corev1.ConditionStatus
+	// Reason is a machine understandable string that gives the reason for
+	// the condition's last transition.
+	Reason string
+	// Message is a human-readable message indicating details about the last
+	// transition.
+	Message string
+	// LastTransitionTime is the time at which the status of the condition
+	// last changed. It is always zero for kinds whose conditions do not
+	// record a transition time.
+	LastTransitionTime metav1.Time
+}
+
+// Getter is implemented by objects whose conditions can be read.
+type Getter interface {
+	// GetConditions returns the conditions of the object.
+	GetConditions() []Condition
+}
+
+// Setter is implemented by objects whose conditions can be read and written.
+type Setter interface {
+	Getter
+	// SetConditions replaces the conditions of the object.
+	SetConditions([]Condition)
+}
+
+// Get returns the condition of the given type, or nil if the object does not
+// have a condition of that type.
+func Get(from Getter, t string) *Condition {
+	for _, c := range from.GetConditions() {
+		if c.Type == t {
+			c := c
+			return &c
+		}
+	}
+	return nil
+}
+
+// Has returns true if the object has a condition of the given type.
+func Has(from Getter, t string) bool {
+	return Get(from, t) != nil
+}
+
+// IsTrue returns true if the condition of the given type has status True.
+func IsTrue(from Getter, t string) bool {
+	c := Get(from, t)
+	return c != nil && c.Status == corev1.ConditionTrue
+}
+
+// IsFalse returns true if the condition of the given type has status False.
+func IsFalse(from Getter, t string) bool {
+	c := Get(from, t)
+	return c != nil && c.Status == corev1.ConditionFalse
+}
+
+// IsUnknown returns true if the condition of the given type has status
+// Unknown or if the object does not have a condition of that type.
+func IsUnknown(from Getter, t string) bool {
+	c := Get(from, t)
+	return c == nil || c.Status == corev1.ConditionUnknown
+}
+
+// Set sets the given condition, replacing any existing condition of the same
+// type. The LastTransitionTime of the condition is set to the current time if
+// the condition is new or its status changed, otherwise the existing
+// LastTransitionTime is kept.
+func Set(to Setter, c Condition) {
+	conditions := to.GetConditions()
+	for i := range conditions {
+		if conditions[i].Type != c.Type {
+			continue
+		}
+		if conditions[i].Status == c.Status {
+			c.LastTransitionTime = conditions[i].LastTransitionTime
+		} else {
+			c.LastTransitionTime = now()
+		}
+		conditions[i] = c
+		to.SetConditions(conditions)
+		return
+	}
+	c.LastTransitionTime = now()
+	to.SetConditions(append(conditions, c))
+}
+
+// MarkTrue sets the condition of the given type to True.
+func MarkTrue(to Setter, t string) {
+	Set(to, Condition{
+		Type:   t,
+		Status: corev1.ConditionTrue,
+	})
+}
+
+// MarkFalse sets the condition of the given type to False with the given
+// reason and message.
+func MarkFalse(to Setter, t, reason, messageFormat string, messageArgs ...interface{}) {
+	Set(to, Condition{
+		Type:    t,
+		Status:  corev1.ConditionFalse,
+		Reason:  reason,
+		Message: fmt.Sprintf(messageFormat, messageArgs...),
+	})
+}
+
+// MarkUnknown sets the condition of the given type to Unknown with the given
+// reason and message.
+func MarkUnknown(to Setter, t, reason, messageFormat string, messageArgs ...interface{}) {
+	Set(to, Condition{
+		Type:    t,
+		Status:  corev1.ConditionUnknown,
+		Reason:  reason,
+		Message: fmt.Sprintf(messageFormat, messageArgs...),
+	})
+}
+
+// Delete removes the condition of the given type.
+func Delete(to Setter, t string) {
+	conditions := to.GetConditions()
+	newConditions := make([]Condition, 0, len(conditions))
+	for _, c := range conditions {
+		if c.Type != t {
+			newConditions = append(newConditions, c)
+		}
+	}
+	to.SetConditions(newConditions)
+}
+
+// now returns the current time truncated to seconds, the precision at which
+// metav1.Time is serialized.
+func now() metav1.Time {
+	return metav1.NewTime(time.Now().UTC().Truncate(time.Second))
+}
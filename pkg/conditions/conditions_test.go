@@ -0,0 +1,123 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions_test
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+)
+
+func TestSetLastTransitionTime(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour).UTC().Truncate(time.Second))
+	network := &v1alpha1.VSphereDistributedNetwork{
+		Status: v1alpha1.VSphereDistributedNetworkStatus{
+			Conditions: []v1alpha1.VSphereDistributedNetworkCondition{{
+				Type:               v1alpha1.VsphereDistributedNetworkIPPoolPressure,
+				Status:             corev1.ConditionFalse,
+				Reason:             "Available",
+				LastTransitionTime: past,
+			}},
+		},
+	}
+	setter, err := conditions.For(network)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pressure := string(v1alpha1.VsphereDistributedNetworkIPPoolPressure)
+
+	// Changing only the reason and message keeps the transition time.
+	conditions.MarkFalse(setter, pressure, "StillAvailable", "%d addresses free", 10)
+	c := conditions.Get(setter, pressure)
+	if c.Reason != "StillAvailable" || c.Message != "10 addresses free" {
+		t.Errorf("condition = %+v, want the new reason and message", c)
+	}
+	if !c.LastTransitionTime.Equal(&past) {
+		t.Errorf("LastTransitionTime = %v, want the unchanged %v", c.LastTransitionTime, past)
+	}
+
+	// Changing the status updates the transition time.
+	conditions.Set(setter, conditions.Condition{Type: pressure, Status: corev1.ConditionTrue, Reason: "Low"})
+	c = conditions.Get(setter, pressure)
+	if !past.Before(&c.LastTransitionTime) {
+		t.Errorf("LastTransitionTime = %v after the status changed, want later than %v", c.LastTransitionTime, past)
+	}
+
+	// A new condition gets the current time.
+	conditions.MarkTrue(setter, string(v1alpha1.VSphereDistributedNetworkPortGroupFailure))
+	if c := conditions.Get(setter, string(v1alpha1.VSphereDistributedNetworkPortGroupFailure)); c.LastTransitionTime.IsZero() {
+		t.Errorf("LastTransitionTime of a new condition is zero")
+	}
+	if n := len(network.Status.Conditions); n != 2 {
+		t.Errorf("network has %d conditions, want 2", n)
+	}
+}
+
+func TestFor(t *testing.T) {
+	objs := []runtime.Object{
+		&v1alpha1.IPPool{},
+		&v1alpha1.LoadBalancerConfig{},
+		&v1alpha1.NetworkInterface{},
+		&v1alpha1.NSXTNetwork{},
+		&v1alpha1.VSphereDistributedNetwork{},
+		&v1alpha2.IPPool{},
+		&v1alpha2.LoadBalancerConfig{},
+		&v1alpha2.NetworkInterface{},
+		&v1alpha2.NSXTNetwork{},
+		&v1alpha2.VSphereDistributedNetwork{},
+	}
+	for _, obj := range objs {
+		setter, err := conditions.For(obj)
+		if err != nil {
+			t.Errorf("For(%T) = %v", obj, err)
+			continue
+		}
+		if conditions.Has(setter, "Ready") || !conditions.IsUnknown(setter, "Ready") {
+			t.Errorf("%T: condition Ready is set before it was set", obj)
+		}
+
+		conditions.MarkTrue(setter, "Ready")
+		conditions.MarkFalse(setter, "Failure", "Reason", "message %s", "args")
+		conditions.MarkUnknown(setter, "Pending", "Reason", "message")
+		if !conditions.IsTrue(setter, "Ready") || conditions.IsFalse(setter, "Ready") {
+			t.Errorf("%T: IsTrue(Ready) = false", obj)
+		}
+		if !conditions.IsFalse(setter, "Failure") || conditions.IsTrue(setter, "Failure") {
+			t.Errorf("%T: IsFalse(Failure) = false", obj)
+		}
+		if !conditions.IsUnknown(setter, "Pending") {
+			t.Errorf("%T: IsUnknown(Pending) = false", obj)
+		}
+		if c := conditions.Get(setter, "Failure"); c == nil || c.Reason != "Reason" || c.Message != "message args" {
+			t.Errorf("%T: Get(Failure) = %+v, want the reason and message that were set", obj, c)
+		}
+
+		// The conditions are written to the object, not only to the setter.
+		again, err := conditions.For(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := len(again.GetConditions()); n != 3 {
+			t.Errorf("%T has %d conditions, want 3", obj, n)
+		}
+
+		conditions.Delete(again, "Ready")
+		if conditions.Has(again, "Ready") || len(again.GetConditions()) != 2 {
+			t.Errorf("%T: conditions after Delete(Ready) = %+v", obj, again.GetConditions())
+		}
+	}
+}
+
+func TestForUnsupported(t *testing.T) {
+	if _, err := conditions.For(&v1alpha1.Network{}); err == nil {
+		t.Errorf("For() of a kind without conditions succeeded")
+	}
+}
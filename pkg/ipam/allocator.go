@@ -0,0 +1,245 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+)
+
+// Allocator hands out addresses from the ranges described by an IPPool.
+// Addresses are identified internally by their offset in the pool, i.e. their
+// index in the list of the addresses of the pool in ascending order.
+type Allocator struct {
+	mu sync.Mutex
+
+	pool     string
+	family   corev1.IPFamily
+	segments []segment
+	size     int64
+
+	// allocated is the set of allocated offsets.
+	allocated map[int64]struct{}
+	// held maps the offsets of released addresses that are held for the
+	// identity of their previous owner to their hold. Holds that have expired
+	// are ignored and removed lazily.
+	held map[int64]hold
+	// now returns the current time, against which holds expire.
+	now func() time.Time
+	// next is the offset at which the search for a free address begins. It
+	// advances after every allocation so that released addresses are not
+	// immediately handed out again.
+	next int64
+}
+
+// segment is a contiguous range of addresses of the pool.
+type segment struct {
+	// start is the first address of the segment.
+	start *big.Int
+	// first is the offset of the first address of the segment.
+	first int64
+	// size is the number of addresses in the segment.
+	size int64
+}
+
+// NewAllocator returns an Allocator for the addresses described by the spec
+// of the given IPPool. The returned allocator has no allocated addresses.
+func NewAllocator(pool *v1alpha1.IPPool) (*Allocator, error) {
+	ranges, err := pool.Spec.AddressRanges()
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", ErrInvalidPool, pool.Name, err)
+	}
+
+	a := &Allocator{
+		pool:      pool.Name,
+		family:    ranges[0].Family(),
+		allocated: map[int64]struct{}{},
+		held:      map[int64]hold{},
+		now:       time.Now,
+	}
+	for _, r := range ranges {
+		a.segments = append(a.segments, segment{start: ipaddr.ToInt(r.Start), first: a.size, size: r.Size()})
+		a.size += r.Size()
+	}
+	return a, nil
+}
+
+// NewAllocatorFromStatus returns an Allocator for the range described by the
+// spec of the given IPPool, with the addresses listed in the pool's
+// Status.Allocations marked as allocated and those listed in Status.Held held
+// for their identity.
+func NewAllocatorFromStatus(pool *v1alpha1.IPPool) (*Allocator, error) {
+	a, err := NewAllocator(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	var state State
+	for _, allocation := range pool.Status.Allocations {
+		state.Allocated = append(state.Allocated, allocation.IP)
+	}
+	for _, h := range pool.Status.Held {
+		state.Held = append(state.Held, HeldState{
+			IP:             h.IP,
+			Identity:       h.Identity,
+			ExpirationTime: h.ExpirationTime.Time,
+		})
+	}
+	if err := a.SetState(state); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Family returns the IP family of the addresses in the pool.
+func (a *Allocator) Family() corev1.IPFamily {
+	return a.family
+}
+
+// Size returns the total number of addresses in the pool.
+func (a *Allocator) Size() int64 {
+	return a.size
+}
+
+// Used returns the number of allocated addresses.
+func (a *Allocator) Used() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return int64(len(a.allocated))
+}
+
+// Free returns the number of addresses that are available for allocation,
+// i.e. neither allocated nor held.
+func (a *Allocator) Free() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.expireHolds()
+	return a.size - int64(len(a.allocated)) - int64(len(a.held))
+}
+
+// Contains returns true if ip is within the ranges of the pool.
+func (a *Allocator) Contains(ip net.IP) bool {
+	_, ok := a.offset(ip)
+	return ok
+}
+
+// IsAllocated returns true if ip is within the ranges of the pool and is
+// allocated.
+func (a *Allocator) IsAllocated(ip net.IP) bool {
+	off, ok := a.offset(ip)
+	if !ok {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, allocated := a.allocated[off]
+	return allocated
+}
+
+// Allocate allocates the next free address in the pool. Held addresses are
+// not allocated. ErrPoolFull is returned if all addresses are allocated or
+// held.
+func (a *Allocator) Allocate() (net.IP, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.allocate()
+}
+
+func (a *Allocator) allocate() (net.IP, error) {
+	a.expireHolds()
+	if int64(len(a.allocated)+len(a.held)) >= a.size {
+		return nil, fmt.Errorf("%w %q", ErrPoolFull, a.pool)
+	}
+
+	for i := int64(0); i < a.size; i++ {
+		off := (a.next + i) % a.size
+		if _, held := a.held[off]; held {
+			continue
+		}
+		if _, allocated := a.allocated[off]; !allocated {
+			a.allocated[off] = struct{}{}
+			a.next = (off + 1) % a.size
+			return a.ip(off), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrPoolFull, a.pool)
+}
+
+// AllocateIP allocates the given address, removing its hold if it is held.
+// ErrNotInPool is returned if ip is outside the ranges of the pool and
+// ErrAllocated if it is already allocated.
+func (a *Allocator) AllocateIP(ip net.IP) error {
+	off, ok := a.offset(ip)
+	if !ok {
+		return fmt.Errorf("%w %q: %s", ErrNotInPool, a.pool, ip)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, allocated := a.allocated[off]; allocated {
+		return fmt.Errorf("%w in pool %q: %s", ErrAllocated, a.pool, ip)
+	}
+	delete(a.held, off)
+	a.allocated[off] = struct{}{}
+	return nil
+}
+
+// Release returns the given address to the pool. ErrNotInPool is returned if
+// ip is outside the ranges of the pool and ErrNotAllocated if it is not
+// allocated.
+func (a *Allocator) Release(ip net.IP) error {
+	off, ok := a.offset(ip)
+	if !ok {
+		return fmt.Errorf("%w %q: %s", ErrNotInPool, a.pool, ip)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, allocated := a.allocated[off]; !allocated {
+		return fmt.Errorf("%w in pool %q: %s", ErrNotAllocated, a.pool, ip)
+	}
+	delete(a.allocated, off)
+	return nil
+}
+
+// offset returns the offset of ip in the pool. The second return value is
+// false if ip is not within the ranges of the pool.
+func (a *Allocator) offset(ip net.IP) (int64, bool) {
+	ip, family, ok := ipaddr.Canonical(ip)
+	if !ok || family != a.family {
+		return 0, false
+	}
+
+	i := ipaddr.ToInt(ip)
+	n := sort.Search(len(a.segments), func(n int) bool { return a.segments[n].start.Cmp(i) > 0 }) - 1
+	if n < 0 {
+		return 0, false
+	}
+	seg := a.segments[n]
+	off := new(big.Int).Sub(i, seg.start)
+	if !off.IsInt64() || off.Int64() >= seg.size {
+		return 0, false
+	}
+	return seg.first + off.Int64(), true
+}
+
+// ip returns the address at the given offset in the pool.
+func (a *Allocator) ip(off int64) net.IP {
+	n := sort.Search(len(a.segments), func(n int) bool { return a.segments[n].first > off }) - 1
+	seg := a.segments[n]
+	return ipaddr.FromInt(new(big.Int).Add(seg.start, big.NewInt(off-seg.first)), a.family)
+}
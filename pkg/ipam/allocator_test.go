@@ -0,0 +1,203 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"errors"
+	"math"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+func newAllocator(t *testing.T, spec v1alpha1.IPPoolSpec) *Allocator {
+	a, err := NewAllocator(&v1alpha1.IPPool{ObjectMeta: metav1.ObjectMeta{Name: "pool"}, Spec: spec})
+	if err != nil {
+		t.Fatalf("NewAllocator() = %v", err)
+	}
+	return a
+}
+
+func TestAllocatorIPv4(t *testing.T) {
+	a := newAllocator(t, v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.254", AddressCount: 3})
+	if a.Family() != corev1.IPv4Protocol || a.Size() != 3 {
+		t.Fatalf("Family() = %s, Size() = %d, want IPv4 and 3", a.Family(), a.Size())
+	}
+
+	// The range crosses the boundary of a /24.
+	for _, want := range []string{"192.168.1.254", "192.168.1.255", "192.168.2.0"} {
+		ip, err := a.Allocate()
+		if err != nil || ip.String() != want {
+			t.Fatalf("Allocate() = %v, %v, want %s", ip, err, want)
+		}
+		if len(ip) != net.IPv4len {
+			t.Errorf("Allocate() = %v of length %d, want a 4 byte address", ip, len(ip))
+		}
+	}
+	if _, err := a.Allocate(); !errors.Is(err, ErrPoolFull) {
+		t.Errorf("Allocate() of a full pool = %v, want ErrPoolFull", err)
+	}
+	if a.Used() != 3 || a.Free() != 0 {
+		t.Errorf("Used() = %d, Free() = %d, want 3 and 0", a.Used(), a.Free())
+	}
+
+	ip := net.ParseIP("192.168.1.255")
+	if err := a.Release(ip); err != nil {
+		t.Fatalf("Release() = %v", err)
+	}
+	if err := a.Release(ip); !errors.Is(err, ErrNotAllocated) {
+		t.Errorf("Release() of a released address = %v, want ErrNotAllocated", err)
+	}
+	if a.IsAllocated(ip) || a.Free() != 1 {
+		t.Errorf("IsAllocated() = %v, Free() = %d after Release(), want false and 1", a.IsAllocated(ip), a.Free())
+	}
+	if got, err := a.Allocate(); err != nil || !got.Equal(ip) {
+		t.Errorf("Allocate() = %v, %v, want the released address %s", got, err, ip)
+	}
+}
+
+func TestAllocatorAllocateIP(t *testing.T) {
+	a := newAllocator(t, v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10})
+	if err := a.AllocateIP(net.ParseIP("192.168.1.15")); err != nil {
+		t.Fatalf("AllocateIP() = %v", err)
+	}
+	if err := a.AllocateIP(net.ParseIP("192.168.1.15")); !errors.Is(err, ErrAllocated) {
+		t.Errorf("AllocateIP() of an allocated address = %v, want ErrAllocated", err)
+	}
+	for _, s := range []string{"192.168.1.9", "192.168.1.20", "fd00::10"} {
+		if err := a.AllocateIP(net.ParseIP(s)); !errors.Is(err, ErrNotInPool) {
+			t.Errorf("AllocateIP(%s) = %v, want ErrNotInPool", s, err)
+		}
+		if err := a.Release(net.ParseIP(s)); !errors.Is(err, ErrNotInPool) {
+			t.Errorf("Release(%s) = %v, want ErrNotInPool", s, err)
+		}
+	}
+	if !a.IsAllocated(net.ParseIP("192.168.1.15")) {
+		t.Errorf("IsAllocated() of the allocated address = false")
+	}
+}
+
+func TestAllocatorIPv6(t *testing.T) {
+	a := newAllocator(t, v1alpha1.IPPoolSpec{StartingAddress: "fd00::ffff", AddressCount: 2})
+	if a.Family() != corev1.IPv6Protocol {
+		t.Fatalf("Family() = %s, want IPv6", a.Family())
+	}
+	for _, want := range []string{"fd00::ffff", "fd00::1:0"} {
+		if ip, err := a.Allocate(); err != nil || ip.String() != want {
+			t.Fatalf("Allocate() = %v, %v, want %s", ip, err, want)
+		}
+	}
+	if _, err := a.Allocate(); !errors.Is(err, ErrPoolFull) {
+		t.Errorf("Allocate() of a full pool = %v, want ErrPoolFull", err)
+	}
+	if a.Contains(net.ParseIP("192.168.1.10")) {
+		t.Errorf("Contains() of an IPv4 address = true")
+	}
+}
+
+func TestAllocatorIPv6Slash64(t *testing.T) {
+	a := newAllocator(t, v1alpha1.IPPoolSpec{CIDRs: []string{"fd00::/64"}})
+	if a.Size() != math.MaxInt64 {
+		t.Fatalf("Size() = %d, want %d", a.Size(), int64(math.MaxInt64))
+	}
+	if ip, err := a.Allocate(); err != nil || ip.String() != "fd00::" {
+		t.Errorf("Allocate() = %v, %v, want fd00::", ip, err)
+	}
+	last := net.ParseIP("fd00::7fff:ffff:ffff:fffe")
+	if err := a.AllocateIP(last); err != nil {
+		t.Errorf("AllocateIP() of the last address of the pool = %v", err)
+	}
+	if err := a.AllocateIP(net.ParseIP("fd00::ffff:ffff:ffff:ffff")); !errors.Is(err, ErrNotInPool) {
+		t.Errorf("AllocateIP() beyond the first MaxInt64 addresses = %v, want ErrNotInPool", err)
+	}
+}
+
+func TestAllocatorSnapshotRestore(t *testing.T) {
+	spec := v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10}
+	a := newAllocator(t, spec)
+	for i := 0; i < 3; i++ {
+		if _, err := a.Allocate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := a.Release(net.ParseIP("192.168.1.11")); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.AllocateIP(net.ParseIP("192.168.1.19")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := a.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() = %v", err)
+	}
+
+	// A new allocator of the same pool, ex. after a restart, has the same
+	// state once restored.
+	restored := newAllocator(t, spec)
+	if err := restored.Restore(data); err != nil {
+		t.Fatalf("Restore() = %v", err)
+	}
+	if got, want := restored.State(), a.State(); !reflect.DeepEqual(got, want) {
+		t.Errorf("State() after Restore() = %+v, want %+v", got, want)
+	}
+	want := []string{"192.168.1.10", "192.168.1.12", "192.168.1.19"}
+	if got := restored.State().Allocated; !reflect.DeepEqual(got, want) {
+		t.Errorf("Allocated = %v, want %v", got, want)
+	}
+
+	if err := restored.Restore([]byte("{")); err == nil {
+		t.Errorf("Restore() of an invalid snapshot succeeded")
+	}
+	other := newAllocator(t, v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 5})
+	if err := other.Restore(data); !errors.Is(err, ErrNotInPool) {
+		t.Errorf("Restore() of addresses outside the pool = %v, want ErrNotInPool", err)
+	}
+	if other.Used() != 0 {
+		t.Errorf("Used() = %d after a failed Restore(), want 0", other.Used())
+	}
+}
+
+// TestAllocatorParallel allocates every address of a pool concurrently, and
+// checks that no address is handed out twice. Run with -race.
+func TestAllocatorParallel(t *testing.T) {
+	const size = 256
+	a := newAllocator(t, v1alpha1.IPPoolSpec{StartingAddress: "10.0.0.0", AddressCount: size})
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = map[string]bool{}
+		full int
+	)
+	for i := 0; i < 2*size; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ip, err := a.Allocate()
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if !errors.Is(err, ErrPoolFull) {
+					t.Errorf("Allocate() = %v", err)
+				}
+				full++
+				return
+			}
+			if seen[ip.String()] {
+				t.Errorf("Allocate() handed out %s twice", ip)
+			}
+			seen[ip.String()] = true
+		}()
+	}
+	wg.Wait()
+	if len(seen) != size || full != size {
+		t.Errorf("allocated %d addresses and got %d ErrPoolFull, want %d and %d", len(seen), full, size, size)
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package capi fulfills the Cluster API IPAM contract with the addresses of
+// net-operator IPPools.
+//
+// Cluster API machines request addresses by creating an IPAddressClaim in the
+// ipam.cluster.x-k8s.io group whose poolRef refers to a pool. The
+// IPAddressClaimReconciler handles the claims whose poolRef refers to an
+// IPPool or a VSphereDistributedNetwork in the netoperator.vmware.com group.
+// An address is allocated from the IPPool, or from the first IPPool of the
+// VSphereDistributedNetwork with a free address, its IPv4 pools before its
+// IPv6 pools, and recorded in the status of the IPPool as owned by the
+// IPAddressClaim. The reconciler then creates an IPAddress named after the
+// claim, whose gateway and prefix come from the configuration of the address's
+// family of the VSphereDistributedNetwork, and sets the claim's addressRef.
+// The address is released when the claim is deleted.
+//
+// The Cluster API types are handled as unstructured objects so that this
+// package does not depend on the version of Kubernetes required by Cluster
+// API.
+package capi
@@ -0,0 +1,372 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package capi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipam"
+)
+
+// requeueAfter is the delay after which a claim that could not be fulfilled
+// is reconciled again.
+const requeueAfter = 30 * time.Second
+
+// IPAddressClaimReconciler fulfills the IPAddressClaims that refer to an
+// IPPool or a VSphereDistributedNetwork. The scheme of the client must include
+// v1alpha1.
+type IPAddressClaimReconciler struct {
+	// Client is used to read and write both the Cluster API and the
+	// net-operator objects.
+	Client client.Client
+
+	// Version is the version of the Cluster API IPAM types. DefaultVersion is
+	// used if empty.
+	Version string
+}
+
+// SetupWithManager adds the reconciler to the given manager.
+func (r *IPAddressClaimReconciler) SetupWithManager(mgr manager.Manager) error {
+	claim := &unstructured.Unstructured{}
+	claim.SetGroupVersionKind(ipAddressClaimGVK(r.version()))
+	ipAddress := &unstructured.Unstructured{}
+	ipAddress.SetGroupVersionKind(ipAddressGVK(r.version()))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("ipaddressclaim").
+		For(claim).
+		Owns(ipAddress).
+		Complete(r)
+}
+
+func (r *IPAddressClaimReconciler) version() string {
+	if r.Version == "" {
+		return DefaultVersion
+	}
+	return r.Version
+}
+
+// Reconcile fulfills or releases the IPAddressClaim of the given request.
+func (r *IPAddressClaimReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	claim := &unstructured.Unstructured{}
+	claim.SetGroupVersionKind(ipAddressClaimGVK(r.version()))
+	if err := r.Client.Get(ctx, req.NamespacedName, claim); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	ref := getPoolRef(claim)
+	if !handles(ref) {
+		return ctrl.Result{}, nil
+	}
+
+	if claim.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, r.reconcileDelete(ctx, claim)
+	}
+	if !containsString(claim.GetFinalizers(), ReleaseAddressFinalizer) {
+		claim.SetFinalizers(append(claim.GetFinalizers(), ReleaseAddressFinalizer))
+		if err := r.Client.Update(ctx, claim); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return r.reconcileNormal(ctx, claim, ref)
+}
+
+// handles returns true if the reconciler fulfills claims for the given pool.
+func handles(ref poolRef) bool {
+	if ref.APIGroup != v1alpha1.GroupName {
+		return false
+	}
+	return ref.Kind == "IPPool" || ref.Kind == "VSphereDistributedNetwork"
+}
+
+func (r *IPAddressClaimReconciler) reconcileNormal(
+	ctx context.Context,
+	claim *unstructured.Unstructured,
+	ref poolRef) (ctrl.Result, error) {
+
+	var origStatus interface{}
+	if status, ok := claim.Object["status"]; ok {
+		origStatus = runtime.DeepCopyJSONValue(status)
+	}
+
+	ipAddress := &unstructured.Unstructured{}
+	ipAddress.SetGroupVersionKind(ipAddressGVK(r.version()))
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: claim.GetNamespace(), Name: claim.GetName()}, ipAddress)
+	switch {
+	case apierrors.IsNotFound(err):
+		reason, err := r.allocate(ctx, claim, ref)
+		if err != nil && reason == "" {
+			return ctrl.Result{}, err
+		}
+		if err != nil {
+			conditions.MarkFalse(claimConditions{claim}, ReadyCondition, reason, "%v", err)
+			if err := r.Client.Status().Update(ctx, claim); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+	case err != nil:
+		return ctrl.Result{}, err
+	}
+
+	if err := unstructured.SetNestedField(claim.Object, claim.GetName(), "status", "addressRef", "name"); err != nil {
+		return ctrl.Result{}, err
+	}
+	conditions.MarkTrue(claimConditions{claim}, ReadyCondition)
+	if equality.Semantic.DeepEqual(origStatus, claim.Object["status"]) {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{}, r.Client.Status().Update(ctx, claim)
+}
+
+// allocate allocates an address for the claim from the referenced pool and
+// creates the IPAddress of the claim. The returned reason is empty if
+// allocating failed because of an API error that should be retried.
+func (r *IPAddressClaimReconciler) allocate(
+	ctx context.Context,
+	claim *unstructured.Unstructured,
+	ref poolRef) (string, error) {
+
+	pools, network, err := r.pools(ctx, ref)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return PoolNotFoundReason, fmt.Errorf("%s %q not found", ref.Kind, ref.Name)
+		}
+		return "", err
+	}
+
+	owner := claimRef(claim)
+	address := ""
+	// Reuse an address allocated by an earlier reconciliation, ex. one whose
+	// creation of the IPAddress failed.
+	for _, pool := range pools {
+		if allocations := pool.GetAllocationsFor(owner); len(allocations) > 0 {
+			address = allocations[0].IP
+			break
+		}
+	}
+	for i := 0; address == "" && i < len(pools); i++ {
+		pool := pools[i]
+		allocator, err := ipam.NewAllocatorFromStatus(pool)
+		if err != nil {
+			continue
+		}
+		ip, err := allocator.Allocate()
+		if err != nil {
+			continue
+		}
+		pool.SetAllocation(ip.String(), owner)
+		if err := r.Client.Status().Update(ctx, pool); err != nil {
+			return "", err
+		}
+		address = ip.String()
+	}
+	if address == "" {
+		return PoolExhaustedReason, fmt.Errorf("no free addresses in %s %q", ref.Kind, ref.Name)
+	}
+
+	gateway, prefix := addressConfig(address, network)
+	ipAddress := newIPAddress(claim, ref, address, prefix, gateway)
+	if err := r.Client.Create(ctx, ipAddress); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", err
+	}
+	return "", nil
+}
+
+// pools returns the IPPools to allocate from for the given reference, and the
+// VSphereDistributedNetwork providing their gateway and prefix. The IPPools of
+// a network are its IPv4 pools followed by its IPv6 pools. The network is nil
+// if the reference is to an IPPool that no network uses.
+func (r *IPAddressClaimReconciler) pools(
+	ctx context.Context,
+	ref poolRef) ([]*v1alpha1.IPPool, *v1alpha1.VSphereDistributedNetwork, error) {
+
+	if ref.Kind == "IPPool" {
+		pool := &v1alpha1.IPPool{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: ref.Name}, pool); err != nil {
+			return nil, nil, err
+		}
+		networks := &v1alpha1.VSphereDistributedNetworkList{}
+		if err := r.Client.List(ctx, networks); err != nil {
+			return nil, nil, err
+		}
+		for i := range networks.Items {
+			for _, poolRef := range poolRefs(&networks.Items[i]) {
+				if poolRef.Name == pool.Name {
+					return []*v1alpha1.IPPool{pool}, &networks.Items[i], nil
+				}
+			}
+		}
+		return []*v1alpha1.IPPool{pool}, nil, nil
+	}
+
+	network := &v1alpha1.VSphereDistributedNetwork{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: ref.Name}, network); err != nil {
+		return nil, nil, err
+	}
+	var pools []*v1alpha1.IPPool
+	for _, poolRef := range poolRefs(network) {
+		pool := &v1alpha1.IPPool{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: poolRef.Name}, pool); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, network, nil
+}
+
+// poolRefs returns the references to the IPPools of both families of the
+// network, without duplicates. The IPv6 pools are skipped unless IPv6
+// addresses are assigned from static pools.
+func poolRefs(network *v1alpha1.VSphereDistributedNetwork) []v1alpha1.IPPoolReference {
+	refs := network.Spec.IPPools
+	if v6 := network.Spec.IPv6; v6 != nil &&
+		(v6.IPAssignmentMode == "" || v6.IPAssignmentMode == v1alpha1.IPAssignmentModeStaticPool) {
+		refs = append(append([]v1alpha1.IPPoolReference{}, refs...), v6.IPPools...)
+	}
+	var out []v1alpha1.IPPoolReference
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		if !seen[ref.Name] {
+			seen[ref.Name] = true
+			out = append(out, ref)
+		}
+	}
+	return out
+}
+
+// addressConfig returns the gateway and prefix length of the given address on
+// the network. The gateway and prefix of IPv6 addresses on a dual-stack network
+// are those of its IPv6 configuration. The network may be nil.
+func addressConfig(address string, network *v1alpha1.VSphereDistributedNetwork) (string, int64) {
+	if network == nil {
+		return "", prefixLength(address, "")
+	}
+	if v6 := network.Spec.IPv6; v6 != nil && net.ParseIP(address).To4() == nil {
+		length := v6.PrefixLength
+		if length == 0 {
+			length = v1alpha1.DefaultIPv6PrefixLength
+		}
+		return v6.Gateway, int64(length)
+	}
+	return network.Spec.Gateway, prefixLength(address, network.Spec.SubnetMask)
+}
+
+// reconcileDelete releases the address of the claim, and removes the
+// finalizers of its IPAddress and of the claim itself.
+func (r *IPAddressClaimReconciler) reconcileDelete(ctx context.Context, claim *unstructured.Unstructured) error {
+	if !containsString(claim.GetFinalizers(), ReleaseAddressFinalizer) {
+		return nil
+	}
+
+	pools := &v1alpha1.IPPoolList{}
+	if err := r.Client.List(ctx, pools); err != nil {
+		return err
+	}
+	owner := claimRef(claim)
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+		if pool.RemoveAllocationsFor(owner) == 0 {
+			continue
+		}
+		if err := r.Client.Status().Update(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	ipAddress := &unstructured.Unstructured{}
+	ipAddress.SetGroupVersionKind(ipAddressGVK(r.version()))
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: claim.GetNamespace(), Name: claim.GetName()}, ipAddress)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return err
+	default:
+		if containsString(ipAddress.GetFinalizers(), ProtectAddressFinalizer) {
+			ipAddress.SetFinalizers(removeString(ipAddress.GetFinalizers(), ProtectAddressFinalizer))
+			if err := r.Client.Update(ctx, ipAddress); err != nil {
+				return err
+			}
+		}
+		if err := r.Client.Delete(ctx, ipAddress); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	claim.SetFinalizers(removeString(claim.GetFinalizers(), ReleaseAddressFinalizer))
+	return r.Client.Update(ctx, claim)
+}
+
+// claimRef returns the reference recorded as the owner of the claim's address
+// in the status of the IPPool. The kind distinguishes a claim from a
+// NetworkInterface with the same namespace and name.
+func claimRef(claim *unstructured.Unstructured) v1alpha1.NetworkInterfaceReference {
+	gvk := claim.GroupVersionKind()
+	return v1alpha1.NetworkInterfaceReference{
+		APIGroup:  gvk.Group,
+		Kind:      gvk.Kind,
+		Name:      claim.GetName(),
+		Namespace: claim.GetNamespace(),
+		UID:       claim.GetUID(),
+	}
+}
+
+// prefixLength returns the prefix length of the given subnet mask, or the
+// length of a single address prefix of the family of address if the mask is
+// empty or invalid.
+func prefixLength(address, subnetMask string) int64 {
+	bits := 128
+	if ip := net.ParseIP(address); ip != nil && ip.To4() != nil {
+		bits = 32
+	}
+	mask := net.ParseIP(subnetMask)
+	if mask == nil {
+		return int64(bits)
+	}
+	if bits == 32 {
+		mask = mask.To4()
+	}
+	ones, maskBits := net.IPMask(mask).Size()
+	if maskBits != bits {
+		return int64(bits)
+	}
+	return int64(ones)
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(s []string, v string) []string {
+	var out []string
+	for _, e := range s {
+		if e != v {
+			out = append(out, e)
+		}
+	}
+	return out
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package capi_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/internal/testenv"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipam/capi"
+)
+
+func newObject(kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: capi.GroupName, Version: capi.DefaultVersion, Kind: kind})
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func newClaim(name, poolKind, poolName string) *unstructured.Unstructured {
+	claim := newObject("IPAddressClaim", "default", name)
+	claim.Object["spec"] = map[string]interface{}{
+		"poolRef": map[string]interface{}{
+			"apiGroup": v1alpha1.GroupName,
+			"kind":     poolKind,
+			"name":     poolName,
+		},
+	}
+	return claim
+}
+
+func TestIPAddressClaimReconcilerEnvtest(t *testing.T) {
+	env := testenv.Start(t, testenv.Options{
+		CRDDirectoryPaths: []string{filepath.Join("testdata", "crds")},
+	})
+	defer env.Stop(t)
+
+	ctx := context.Background()
+	c := env.Client
+	for _, obj := range []runtime.Object{
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+			Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 1},
+		},
+		&v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				IPPools:          []v1alpha1.IPPoolReference{{Name: "pool"}},
+				Gateway:          "192.168.1.1",
+				SubnetMask:       "255.255.255.0",
+			},
+		},
+	} {
+		if err := c.Create(ctx, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := &capi.IPAddressClaimReconciler{Client: c}
+	reconcile := func(name string) ctrl.Result {
+		t.Helper()
+		result, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}})
+		if err != nil {
+			t.Fatalf("Reconcile(%s) = %v", name, err)
+		}
+		return result
+	}
+
+	// The claim is fulfilled with the address of the pool, and the gateway and
+	// prefix of the network.
+	claim := newClaim("claim", "VSphereDistributedNetwork", "vdn")
+	if err := c.Create(ctx, claim); err != nil {
+		t.Fatal(err)
+	}
+	reconcile("claim")
+
+	ipAddress := newObject("IPAddress", "default", "claim")
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "claim"}, ipAddress); err != nil {
+		t.Fatalf("IPAddress of the claim: %v", err)
+	}
+	address, _, _ := unstructured.NestedString(ipAddress.Object, "spec", "address")
+	gateway, _, _ := unstructured.NestedString(ipAddress.Object, "spec", "gateway")
+	prefix, _, _ := unstructured.NestedInt64(ipAddress.Object, "spec", "prefix")
+	if address != "192.168.1.10" || gateway != "192.168.1.1" || prefix != 24 {
+		t.Errorf("IPAddress spec = %v, want address 192.168.1.10, gateway 192.168.1.1 and prefix 24",
+			ipAddress.Object["spec"])
+	}
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "claim"}, claim); err != nil {
+		t.Fatal(err)
+	}
+	if addressRef, _, _ := unstructured.NestedString(claim.Object, "status", "addressRef", "name"); addressRef != "claim" {
+		t.Errorf("addressRef of the claim = %q, want claim", addressRef)
+	}
+
+	pool := &v1alpha1.IPPool{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "pool"}, pool); err != nil {
+		t.Fatal(err)
+	}
+	a := pool.GetAllocation("192.168.1.10")
+	if a == nil || a.NetworkInterfaceRef.Kind != "IPAddressClaim" || a.NetworkInterfaceRef.APIGroup != capi.GroupName {
+		t.Fatalf("allocation of 192.168.1.10 = %+v, want one owned by the claim", a)
+	}
+
+	// A claim for an exhausted pool is not ready.
+	other := newClaim("other", "IPPool", "pool")
+	if err := c.Create(ctx, other); err != nil {
+		t.Fatal(err)
+	}
+	if result := reconcile("other"); result.RequeueAfter == 0 {
+		t.Errorf("claim of an exhausted pool is not requeued")
+	}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "other"}, other); err != nil {
+		t.Fatal(err)
+	}
+	conditions, _, _ := unstructured.NestedSlice(other.Object, "status", "conditions")
+	if len(conditions) != 1 || conditions[0].(map[string]interface{})["reason"] != capi.PoolExhaustedReason {
+		t.Errorf("conditions of the claim of an exhausted pool = %v, want %s", conditions, capi.PoolExhaustedReason)
+	}
+
+	// The address is released when the claim is deleted.
+	if err := c.Delete(ctx, claim); err != nil {
+		t.Fatal(err)
+	}
+	reconcile("claim")
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "claim"}, newObject("IPAddress", "", "")); !apierrors.IsNotFound(err) {
+		t.Errorf("IPAddress of the deleted claim: %v, want it deleted", err)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "claim"}, newObject("IPAddressClaim", "", "")); !apierrors.IsNotFound(err) {
+		t.Errorf("deleted claim: %v, want its finalizer removed", err)
+	}
+	released := &v1alpha1.IPPool{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "pool"}, released); err != nil {
+		t.Fatal(err)
+	}
+	if a := released.GetAllocation("192.168.1.10"); a != nil {
+		t.Errorf("allocation %+v of the deleted claim was not released", a)
+	}
+
+	// The released address fulfills the other claim.
+	reconcile("other")
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "other"}, newObject("IPAddress", "", "")); err != nil {
+		t.Errorf("IPAddress of the claim of the released address: %v", err)
+	}
+}
+
+// TestIPAddressClaimReconcilerDualStack tests that the claims of a dual-stack
+// network are fulfilled from the pools of both families, with the gateway and
+// prefix of the family of their address.
+func TestIPAddressClaimReconcilerDualStack(t *testing.T) {
+	// The only address of the IPv4 pool is allocated.
+	pool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 1},
+	}
+	pool.SetAllocation("192.168.1.10", v1alpha1.NetworkInterfaceReference{Name: "ni", Namespace: "default"})
+	network := func(v6 v1alpha1.IPv6Config) *v1alpha1.VSphereDistributedNetwork {
+		v6.IPPools = []v1alpha1.IPPoolReference{{Name: "pool6"}}
+		return &v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				IPPools:          []v1alpha1.IPPoolReference{{Name: "pool"}},
+				Gateway:          "192.168.1.1",
+				SubnetMask:       "255.255.255.0",
+				IPv6:             &v6,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		network  *v1alpha1.VSphereDistributedNetwork
+		poolKind string
+		poolName string
+		address  string
+		gateway  string
+		prefix   int64
+	}{
+		{
+			name:     "network",
+			network:  network(v1alpha1.IPv6Config{Gateway: "fd00::1", PrefixLength: 80}),
+			poolKind: "VSphereDistributedNetwork",
+			poolName: "vdn",
+			address:  "fd00::10",
+			gateway:  "fd00::1",
+			prefix:   80,
+		},
+		{
+			name:     "IPv6 pool",
+			network:  network(v1alpha1.IPv6Config{Gateway: "fd00::1"}),
+			poolKind: "IPPool",
+			poolName: "pool6",
+			address:  "fd00::10",
+			gateway:  "fd00::1",
+			prefix:   v1alpha1.DefaultIPv6PrefixLength,
+		},
+		{
+			name:     "SLAAC",
+			network:  network(v1alpha1.IPv6Config{IPAssignmentMode: v1alpha1.IPAssignmentModeSLAAC}),
+			poolKind: "VSphereDistributedNetwork",
+			poolName: "vdn",
+		},
+	}
+	for _, test := range tests {
+		pool6 := &v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool6"},
+			Spec:       v1alpha1.IPPoolSpec{StartingAddress: "fd00::10", AddressCount: 10},
+		}
+		scheme := runtime.NewScheme()
+		if err := v1alpha1.AddToScheme(scheme); err != nil {
+			t.Fatal(err)
+		}
+		c := fake.NewFakeClientWithScheme(scheme, pool.DeepCopy(), pool6, test.network,
+			newClaim("claim", test.poolKind, test.poolName))
+		r := &capi.IPAddressClaimReconciler{Client: c}
+		if _, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "claim"}}); err != nil {
+			t.Fatalf("%s: Reconcile() = %v", test.name, err)
+		}
+
+		ipAddress := newObject("IPAddress", "default", "claim")
+		err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "claim"}, ipAddress)
+		if test.address == "" {
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("%s: IPAddress of the claim = %v, %v, want not found", test.name, ipAddress.Object["spec"], err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: IPAddress of the claim: %v", test.name, err)
+		}
+		address, _, _ := unstructured.NestedString(ipAddress.Object, "spec", "address")
+		gateway, _, _ := unstructured.NestedString(ipAddress.Object, "spec", "gateway")
+		prefix, _, _ := unstructured.NestedInt64(ipAddress.Object, "spec", "prefix")
+		if address != test.address || gateway != test.gateway || prefix != test.prefix {
+			t.Errorf("%s: IPAddress spec = %v, want address %s, gateway %s and prefix %d",
+				test.name, ipAddress.Object["spec"], test.address, test.gateway, test.prefix)
+		}
+	}
+}
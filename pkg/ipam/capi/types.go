@@ -0,0 +1,158 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package capi
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+)
+
+const (
+	// GroupName is the group of the Cluster API IPAM types.
+	GroupName = "ipam.cluster.x-k8s.io"
+
+	// DefaultVersion is the version of the Cluster API IPAM types used when
+	// the reconciler does not specify one.
+	DefaultVersion = "v1beta1"
+
+	// ReleaseAddressFinalizer is added to IPAddressClaims to release their
+	// address before they are deleted.
+	ReleaseAddressFinalizer = "ipam.cluster.x-k8s.io/ReleaseAddress"
+
+	// ProtectAddressFinalizer is added to IPAddresses to prevent them from
+	// being deleted while their address is allocated.
+	ProtectAddressFinalizer = "ipam.cluster.x-k8s.io/ProtectAddress"
+
+	// ReadyCondition is the type of the condition reporting whether an
+	// address has been allocated for an IPAddressClaim.
+	ReadyCondition = "Ready"
+
+	// PoolExhaustedReason is the reason set on a False ReadyCondition when
+	// there are no free addresses in the referenced pools.
+	PoolExhaustedReason = "PoolExhausted"
+
+	// PoolNotFoundReason is the reason set on a False ReadyCondition when the
+	// referenced pool does not exist.
+	PoolNotFoundReason = "PoolNotFound"
+)
+
+// ipAddressClaimGVK returns the GroupVersionKind of IPAddressClaim for the
+// given version.
+func ipAddressClaimGVK(version string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: GroupName, Version: version, Kind: "IPAddressClaim"}
+}
+
+// ipAddressGVK returns the GroupVersionKind of IPAddress for the given
+// version.
+func ipAddressGVK(version string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: GroupName, Version: version, Kind: "IPAddress"}
+}
+
+// poolRef is the reference to a pool in the spec of IPAddressClaims and
+// IPAddresses.
+type poolRef struct {
+	APIGroup string
+	Kind     string
+	Name     string
+}
+
+// getPoolRef returns the spec.poolRef of an IPAddressClaim.
+func getPoolRef(claim *unstructured.Unstructured) poolRef {
+	apiGroup, _, _ := unstructured.NestedString(claim.Object, "spec", "poolRef", "apiGroup")
+	kind, _, _ := unstructured.NestedString(claim.Object, "spec", "poolRef", "kind")
+	name, _, _ := unstructured.NestedString(claim.Object, "spec", "poolRef", "name")
+	return poolRef{APIGroup: apiGroup, Kind: kind, Name: name}
+}
+
+// newIPAddress returns an IPAddress for the given claim, owned by the claim.
+func newIPAddress(claim *unstructured.Unstructured, ref poolRef, address string, prefix int64, gateway string) *unstructured.Unstructured {
+	ipAddress := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"claimRef": map[string]interface{}{
+				"name": claim.GetName(),
+			},
+			"poolRef": map[string]interface{}{
+				"apiGroup": ref.APIGroup,
+				"kind":     ref.Kind,
+				"name":     ref.Name,
+			},
+			"address": address,
+			"prefix":  prefix,
+		},
+	}}
+	if gateway != "" {
+		_ = unstructured.SetNestedField(ipAddress.Object, gateway, "spec", "gateway")
+	}
+	ipAddress.SetGroupVersionKind(ipAddressGVK(claim.GroupVersionKind().Version))
+	ipAddress.SetNamespace(claim.GetNamespace())
+	ipAddress.SetName(claim.GetName())
+	ipAddress.SetFinalizers([]string{ProtectAddressFinalizer})
+
+	controller := true
+	ipAddress.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: claim.GetAPIVersion(),
+		Kind:       claim.GetKind(),
+		Name:       claim.GetName(),
+		UID:        claim.GetUID(),
+		Controller: &controller,
+	}})
+	return ipAddress
+}
+
+// claimConditions adapts the Cluster API conditions in the status of an
+// IPAddressClaim to a conditions.Setter.
+type claimConditions struct {
+	*unstructured.Unstructured
+}
+
+func (c claimConditions) GetConditions() []conditions.Condition {
+	list, _, _ := unstructured.NestedSlice(c.Object, "status", "conditions")
+	result := make([]conditions.Condition, 0, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cond := conditions.Condition{}
+		cond.Type, _, _ = unstructured.NestedString(m, "type")
+		status, _, _ := unstructured.NestedString(m, "status")
+		cond.Status = corev1.ConditionStatus(status)
+		cond.Reason, _, _ = unstructured.NestedString(m, "reason")
+		cond.Message, _, _ = unstructured.NestedString(m, "message")
+		if s, _, _ := unstructured.NestedString(m, "lastTransitionTime"); s != "" {
+			_ = cond.LastTransitionTime.UnmarshalQueryParameter(s)
+		}
+		result = append(result, cond)
+	}
+	return result
+}
+
+func (c claimConditions) SetConditions(list []conditions.Condition) {
+	result := make([]interface{}, 0, len(list))
+	for _, cond := range list {
+		m := map[string]interface{}{
+			"type":   cond.Type,
+			"status": string(cond.Status),
+		}
+		// Cluster API requires a severity on False conditions.
+		if cond.Status == corev1.ConditionFalse {
+			m["severity"] = "Error"
+		}
+		if cond.Reason != "" {
+			m["reason"] = cond.Reason
+		}
+		if cond.Message != "" {
+			m["message"] = cond.Message
+		}
+		if s, err := cond.LastTransitionTime.MarshalQueryParameter(); err == nil && s != "" {
+			m["lastTransitionTime"] = s
+		}
+		result = append(result, m)
+	}
+	_ = unstructured.SetNestedSlice(c.Object, result, "status", "conditions")
+}
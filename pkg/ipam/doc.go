@@ -0,0 +1,23 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ipam implements an IP address allocator for IPPool resources.
+//
+// An Allocator is built from an IPPool's spec and hands out, releases and
+// checks addresses within the pool's ranges and CIDRs, skipping its
+// exclusions. Both IPv4 and IPv6 pools are supported. The allocator's state
+// may be captured with Snapshot and loaded with Restore so that allocations
+// survive a restart of the process that owns the allocator. All methods are
+// safe for concurrent use.
+//
+// Pools with sticky allocation hold the address of a deleted NetworkInterface
+// for its identity, see Identity, until the grace period of the pool expires.
+// The holds are recorded in Status.Held of the IPPool, see
+// IPPool.ReleaseAllocationsFor, which is their only source of truth: an
+// Allocator only learns about them from NewAllocatorFromStatus or SetState.
+// Held addresses are not handed out by Allocate, AllocateFor returns the
+// address held for an identity before a free one.
+//
+// PoolForRequestedIP finds the pool from which an address requested by a
+// NetworkInterface can be allocated.
+package ipam
@@ -0,0 +1,21 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"errors"
+)
+
+var (
+	// ErrPoolFull is returned when there are no free addresses left in the pool.
+	ErrPoolFull = errors.New("no free addresses in pool")
+	// ErrNotInPool is returned when an address is outside the ranges of the pool.
+	ErrNotInPool = errors.New("address is not in pool")
+	// ErrAllocated is returned when an address is already allocated.
+	ErrAllocated = errors.New("address is already allocated")
+	// ErrNotAllocated is returned when releasing an address that is not allocated.
+	ErrNotAllocated = errors.New("address is not allocated")
+	// ErrInvalidPool is returned when the IPPool spec does not describe a valid range.
+	ErrInvalidPool = errors.New("invalid pool")
+)
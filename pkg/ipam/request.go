@@ -0,0 +1,43 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"fmt"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+)
+
+// PoolForRequestedIP returns the pool from which the given address requested
+// by the given NetworkInterface can be allocated to it, i.e. the first pool
+// whose range contains the address and in whose status the address is free,
+// already allocated to the NetworkInterface, or held for its identity. Pools
+// with an invalid spec are skipped. ErrNotInPool is returned if no pool
+// contains the address and ErrAllocated if it is allocated to another
+// NetworkInterface or held for another identity.
+func PoolForRequestedIP(pools []*v1alpha1.IPPool, ip string, ni *v1alpha1.NetworkInterface) (*v1alpha1.IPPool, error) {
+	addr, _, ok := ipaddr.Parse(ip)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a valid IP address", ip)
+	}
+	owner := v1alpha1.NetworkInterfaceReference{Name: ni.Name, Namespace: ni.Namespace, UID: ni.UID}
+	for _, pool := range pools {
+		a, err := NewAllocatorFromStatus(pool)
+		if err != nil || !a.Contains(addr) {
+			continue
+		}
+		if allocation := pool.GetAllocation(ip); allocation != nil && !allocation.NetworkInterfaceRef.Matches(owner) {
+			return nil, fmt.Errorf("%w in pool %q: %s is allocated to %s",
+				ErrAllocated, pool.Name, ip, allocation.NetworkInterfaceRef)
+		}
+		if a.isHeld(addr) {
+			if identity := Identity(ni, pool.Spec.StickyAllocation); identity == "" || !addr.Equal(a.HeldFor(identity)) {
+				return nil, fmt.Errorf("%w in pool %q: %s is held for another identity", ErrAllocated, pool.Name, ip)
+			}
+		}
+		return pool, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotInPool, ip)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+func TestPoolForRequestedIP(t *testing.T) {
+	controller := true
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ni",
+			Namespace: "ns",
+			UID:       "ni-uid",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "vmoperator.vmware.com/v1alpha1",
+				Kind:       "VirtualMachine",
+				Name:       "vm",
+				UID:        "vm-uid",
+				Controller: &controller,
+			}},
+		},
+	}
+	invalid := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1", AddressCount: 10},
+	}
+	pool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec: v1alpha1.IPPoolSpec{
+			StartingAddress:  "192.168.1.10",
+			AddressCount:     10,
+			StickyAllocation: &v1alpha1.StickyAllocation{},
+		},
+	}
+	pool.SetAllocation("192.168.1.11", v1alpha1.NetworkInterfaceReference{Name: "ni", Namespace: "ns", UID: "ni-uid"})
+	pool.SetAllocation("192.168.1.12", v1alpha1.NetworkInterfaceReference{Name: "other", Namespace: "ns", UID: "other-uid"})
+	expiration := metav1.NewTime(time.Now().Add(time.Hour))
+	pool.Status.Held = []v1alpha1.IPPoolHeldAddress{
+		{IP: "192.168.1.13", Identity: "ns/VirtualMachine/vm", ExpirationTime: expiration},
+		{IP: "192.168.1.14", Identity: "ns/VirtualMachine/other", ExpirationTime: expiration},
+	}
+	pool6 := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool6"},
+		Spec:       v1alpha1.IPPoolSpec{CIDRs: []string{"fd00::/120"}},
+	}
+	pools := []*v1alpha1.IPPool{invalid, pool, pool6}
+
+	tests := []struct {
+		name string
+		ip   string
+		pool string
+		err  error
+	}{
+		{"free", "192.168.1.10", "pool", nil},
+		{"allocated to the interface", "192.168.1.11", "pool", nil},
+		{"held for the identity of the interface", "192.168.1.13", "pool", nil},
+		{"IPv6", "fd00::10", "pool6", nil},
+		{"non-canonical IPv6", "fd00:0::10", "pool6", nil},
+		{"allocated to another interface", "192.168.1.12", "", ErrAllocated},
+		{"held for another identity", "192.168.1.14", "", ErrAllocated},
+		{"outside the pools", "192.168.1.20", "", ErrNotInPool},
+		{"outside the IPv6 pool", "fd00::1:10", "", ErrNotInPool},
+	}
+	for _, tt := range tests {
+		got, err := PoolForRequestedIP(pools, tt.ip, ni)
+		switch {
+		case tt.err != nil && !errors.Is(err, tt.err):
+			t.Errorf("%s: PoolForRequestedIP(%s) = %v, %v, want %v", tt.name, tt.ip, got, err, tt.err)
+		case tt.err == nil && (err != nil || got.Name != tt.pool):
+			t.Errorf("%s: PoolForRequestedIP(%s) = %v, %v, want pool %s", tt.name, tt.ip, got, err, tt.pool)
+		}
+	}
+
+	if _, err := PoolForRequestedIP(pools, "192.168.1", ni); err == nil {
+		t.Errorf("PoolForRequestedIP() of an invalid address succeeded")
+	}
+
+	// Without an identity, no held address can be requested.
+	ni.OwnerReferences = nil
+	if _, err := PoolForRequestedIP(pools, "192.168.1.13", ni); !errors.Is(err, ErrAllocated) {
+		t.Errorf("PoolForRequestedIP() of a held address without an identity = %v, want ErrAllocated", err)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+)
+
+// State is the persisted form of an Allocator. It does not record the ranges
+// of the pool, which come from the spec of the IPPool the state is loaded
+// into.
+type State struct {
+	// Allocated is the list of allocated addresses, in ascending order.
+	Allocated []string `json:"allocated,omitempty"`
+	// Held is the list of held addresses, in ascending order.
+	Held []HeldState `json:"held,omitempty"`
+}
+
+// HeldState is the persisted form of a held address.
+type HeldState struct {
+	// IP is the held address.
+	IP string `json:"ip"`
+	// Identity is the identity the address is held for.
+	Identity string `json:"identity"`
+	// ExpirationTime is the time after which the address is no longer held.
+	ExpirationTime time.Time `json:"expirationTime"`
+}
+
+// State returns the current state of the allocator.
+func (a *Allocator) State() State {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	offsets := make([]int64, 0, len(a.allocated))
+	for off := range a.allocated {
+		offsets = append(offsets, off)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	var state State
+	for _, off := range offsets {
+		state.Allocated = append(state.Allocated, a.ip(off).String())
+	}
+
+	a.expireHolds()
+	offsets = offsets[:0]
+	for off := range a.held {
+		offsets = append(offsets, off)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	for _, off := range offsets {
+		h := a.held[off]
+		state.Held = append(state.Held, HeldState{
+			IP:             a.ip(off).String(),
+			Identity:       h.identity,
+			ExpirationTime: h.expiration,
+		})
+	}
+	return state
+}
+
+// SetState replaces the allocations and holds of the allocator with those in
+// the given state. Held addresses that are also allocated are not held. Every
+// allocated or held address must be within the ranges of the allocator. The
+// allocator is left unchanged if an error is returned.
+func (a *Allocator) SetState(state State) error {
+	allocated := make(map[int64]struct{}, len(state.Allocated))
+	for _, s := range state.Allocated {
+		ip, _, ok := ipaddr.Parse(s)
+		if !ok {
+			return fmt.Errorf("invalid address %q in state of pool %q", s, a.pool)
+		}
+		off, ok := a.offset(ip)
+		if !ok {
+			return fmt.Errorf("%w %q: %s", ErrNotInPool, a.pool, s)
+		}
+		allocated[off] = struct{}{}
+	}
+	held := make(map[int64]hold, len(state.Held))
+	for _, h := range state.Held {
+		ip, _, ok := ipaddr.Parse(h.IP)
+		if !ok {
+			return fmt.Errorf("invalid held address %q in state of pool %q", h.IP, a.pool)
+		}
+		off, ok := a.offset(ip)
+		if !ok {
+			return fmt.Errorf("%w %q: %s", ErrNotInPool, a.pool, h.IP)
+		}
+		if _, ok := allocated[off]; ok {
+			continue
+		}
+		held[off] = hold{identity: h.Identity, expiration: h.ExpirationTime}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.allocated = allocated
+	a.held = held
+	a.next = 0
+	return nil
+}
+
+// Snapshot returns the current state of the allocator serialized as JSON.
+func (a *Allocator) Snapshot() ([]byte, error) {
+	return json.Marshal(a.State())
+}
+
+// Restore replaces the allocations of the allocator with those in the given
+// snapshot, as returned by Snapshot.
+func (a *Allocator) Restore(data []byte) error {
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to decode state of pool %q: %w", a.pool, err)
+	}
+	return a.SetState(state)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// hold is a released address held for the identity of its previous owner.
+type hold struct {
+	identity   string
+	expiration time.Time
+}
+
+// HeldFor returns the address held for the given identity, or nil if no
+// address is held for it.
+func (a *Allocator) HeldFor(identity string) net.IP {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	off, ok := a.heldFor(identity)
+	if !ok {
+		return nil
+	}
+	return a.ip(off)
+}
+
+// AllocateFor allocates the address held for the given identity, or the next
+// free address in the pool if no address is held for it. An empty identity
+// is never held for, see Allocate.
+func (a *Allocator) AllocateFor(identity string) (net.IP, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if off, ok := a.heldFor(identity); ok {
+		delete(a.held, off)
+		a.allocated[off] = struct{}{}
+		return a.ip(off), nil
+	}
+	return a.allocate()
+}
+
+// Held returns the number of held addresses.
+func (a *Allocator) Held() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.expireHolds()
+	return int64(len(a.held))
+}
+
+// heldFor returns the offset of the address held for identity. a.mu must be
+// held.
+func (a *Allocator) heldFor(identity string) (int64, bool) {
+	if identity == "" {
+		return 0, false
+	}
+	a.expireHolds()
+	for off, h := range a.held {
+		if h.identity == identity {
+			return off, true
+		}
+	}
+	return 0, false
+}
+
+// expireHolds removes the holds that have expired. a.mu must be held.
+func (a *Allocator) expireHolds() {
+	now := a.now()
+	for off, h := range a.held {
+		if !now.Before(h.expiration) {
+			delete(a.held, off)
+		}
+	}
+}
+
+// Identity returns the sticky allocation identity of the given
+// NetworkInterface under the given policy, or an empty string if the
+// NetworkInterface has no identity or the policy is nil. The identity is the
+// namespace of the NetworkInterface together with the value of the policy's
+// identity label, or with the kind and name of the controller owner of the
+// NetworkInterface if the policy has no identity label.
+func Identity(ni *v1alpha1.NetworkInterface, policy *v1alpha1.StickyAllocation) string {
+	if policy == nil {
+		return ""
+	}
+	if policy.IdentityLabel != "" {
+		value, ok := ni.Labels[policy.IdentityLabel]
+		if !ok {
+			return ""
+		}
+		return fmt.Sprintf("%s/%s=%s", ni.Namespace, policy.IdentityLabel, value)
+	}
+	owner := metav1.GetControllerOf(ni)
+	if owner == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", ni.Namespace, owner.Kind, owner.Name)
+}
+
+// isHeld returns true if ip is within the ranges of the pool and is held.
+func (a *Allocator) isHeld(ip net.IP) bool {
+	off, ok := a.offset(ip)
+	if !ok {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.expireHolds()
+	_, held := a.held[off]
+	return held
+}
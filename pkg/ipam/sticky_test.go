@@ -0,0 +1,106 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// newStickyPool returns a pool of two addresses, the first of which is held
+// for the identity "ns/VirtualMachine/vm" until the given time.
+func newStickyPool(expiration time.Time) *v1alpha1.IPPool {
+	return &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec: v1alpha1.IPPoolSpec{
+			StartingAddress:  "192.168.1.10",
+			AddressCount:     2,
+			StickyAllocation: &v1alpha1.StickyAllocation{},
+		},
+		Status: v1alpha1.IPPoolStatus{
+			Held: []v1alpha1.IPPoolHeldAddress{{
+				IP:             "192.168.1.10",
+				Identity:       "ns/VirtualMachine/vm",
+				ExpirationTime: metav1.NewTime(expiration),
+			}},
+		},
+	}
+}
+
+func TestHeldFromStatus(t *testing.T) {
+	a, err := NewAllocatorFromStatus(newStickyPool(time.Now().Add(time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip := a.HeldFor("ns/VirtualMachine/vm"); ip == nil || ip.String() != "192.168.1.10" {
+		t.Errorf("HeldFor() = %v, want 192.168.1.10", ip)
+	}
+	if ip := a.HeldFor("ns/VirtualMachine/other"); ip != nil {
+		t.Errorf("HeldFor() of another identity = %v, want nil", ip)
+	}
+	if a.Held() != 1 || a.Free() != 1 {
+		t.Errorf("Held() = %d, Free() = %d, want 1 and 1", a.Held(), a.Free())
+	}
+
+	// The held address is only allocated for its identity.
+	if ip, err := a.AllocateFor("ns/VirtualMachine/other"); err != nil || ip.String() != "192.168.1.11" {
+		t.Errorf("AllocateFor() of another identity = %v, %v, want 192.168.1.11", ip, err)
+	}
+	if _, err := a.Allocate(); err == nil {
+		t.Errorf("Allocate() handed out the held address")
+	}
+	if ip, err := a.AllocateFor("ns/VirtualMachine/vm"); err != nil || ip.String() != "192.168.1.10" {
+		t.Errorf("AllocateFor() = %v, %v, want the held address 192.168.1.10", ip, err)
+	}
+	if a.Held() != 0 {
+		t.Errorf("Held() = %d after the held address was allocated, want 0", a.Held())
+	}
+}
+
+func TestHeldExpiration(t *testing.T) {
+	expiration := time.Now().Add(time.Hour)
+	a, err := NewAllocatorFromStatus(newStickyPool(expiration))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.now = func() time.Time { return expiration }
+	if ip := a.HeldFor("ns/VirtualMachine/vm"); ip != nil {
+		t.Errorf("HeldFor() = %v after the hold expired, want nil", ip)
+	}
+	if ip, err := a.Allocate(); err != nil || ip.String() != "192.168.1.10" {
+		t.Errorf("Allocate() = %v, %v, want the address whose hold expired", ip, err)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	controller := true
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ni",
+			Namespace: "ns",
+			Labels:    map[string]string{"app": "appliance"},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "VirtualMachine", Name: "vm", Controller: &controller},
+			},
+		},
+	}
+	tests := []struct {
+		policy *v1alpha1.StickyAllocation
+		want   string
+	}{
+		{nil, ""},
+		{&v1alpha1.StickyAllocation{}, "ns/VirtualMachine/vm"},
+		{&v1alpha1.StickyAllocation{IdentityLabel: "app"}, "ns/app=appliance"},
+		{&v1alpha1.StickyAllocation{IdentityLabel: "missing"}, ""},
+	}
+	for _, tt := range tests {
+		if got := Identity(ni, tt.policy); got != tt.want {
+			t.Errorf("Identity(%+v) = %q, want %q", tt.policy, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cloudinit renders the network configuration of a guest as
+// cloud-init network configuration version 2, the netplan format.
+package cloudinit
+
+import (
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render"
+)
+
+// Version is the version of the cloud-init network configuration format.
+const Version = 2
+
+// NetworkConfig is the top level of a cloud-init network configuration.
+type NetworkConfig struct {
+	Network Network `json:"network"`
+}
+
+// Network is a cloud-init network configuration version 2.
+type Network struct {
+	Version   int                 `json:"version"`
+	Ethernets map[string]Ethernet `json:"ethernets,omitempty"`
+}
+
+// Ethernet is the configuration of an ethernet device.
+type Ethernet struct {
+	Match       Match        `json:"match"`
+	SetName     string       `json:"set-name,omitempty"`
+	DHCP4       bool         `json:"dhcp4"`
+	DHCP6       bool         `json:"dhcp6,omitempty"`
+	AcceptRA    bool         `json:"accept-ra,omitempty"`
+	Addresses   []string     `json:"addresses,omitempty"`
+	Routes      []Route      `json:"routes,omitempty"`
+	Nameservers *Nameservers `json:"nameservers,omitempty"`
+}
+
+// Match selects the device an Ethernet configures.
+type Match struct {
+	MacAddress string `json:"macaddress"`
+}
+
+// Route is a route of a device. A route without Via has the link scope.
+type Route struct {
+	To     string `json:"to"`
+	Via    string `json:"via,omitempty"`
+	Scope  string `json:"scope,omitempty"`
+	Metric int32  `json:"metric,omitempty"`
+}
+
+// Nameservers are the DNS settings of a device.
+type Nameservers struct {
+	Addresses []string `json:"addresses,omitempty"`
+	Search    []string `json:"search,omitempty"`
+}
+
+// Config returns the cloud-init network configuration of the given
+// interfaces. Interfaces are matched by their MAC address and renamed to
+// their name. IPv4 and IPv6 default routes are added for the gateways of the
+// interfaces, followed by their static routes.
+func Config(interfaces []render.Interface) *NetworkConfig {
+	config := &NetworkConfig{
+		Network: Network{
+			Version:   Version,
+			Ethernets: make(map[string]Ethernet, len(interfaces)),
+		},
+	}
+	for _, iface := range interfaces {
+		eth := Ethernet{
+			Match:    Match{MacAddress: iface.MacAddress},
+			SetName:  iface.Name,
+			DHCP4:    iface.DHCP4,
+			DHCP6:    iface.DHCP6,
+			AcceptRA: iface.AcceptRA,
+		}
+		for _, addr := range iface.Addresses {
+			eth.Addresses = append(eth.Addresses, addr.CIDR())
+		}
+		if gw := iface.Gateway4(); gw != nil {
+			eth.Routes = append(eth.Routes, Route{To: "0.0.0.0/0", Via: gw.String()})
+		}
+		if gw := iface.Gateway6(); gw != nil {
+			eth.Routes = append(eth.Routes, Route{To: "::/0", Via: gw.String()})
+		}
+		for _, r := range iface.Routes {
+			route := Route{To: r.Destination.String(), Scope: "link", Metric: r.Metric}
+			if r.NextHop != nil {
+				route.Via = r.NextHop.String()
+				route.Scope = ""
+			}
+			eth.Routes = append(eth.Routes, route)
+		}
+		if len(iface.Nameservers) > 0 || len(iface.SearchDomains) > 0 {
+			eth.Nameservers = &Nameservers{
+				Addresses: iface.Nameservers,
+				Search:    iface.SearchDomains,
+			}
+		}
+		config.Network.Ethernets[iface.Name] = eth
+	}
+	return config
+}
+
+// Marshal returns the cloud-init network configuration of the given
+// interfaces as YAML.
+func Marshal(interfaces []render.Interface) ([]byte, error) {
+	return yaml.Marshal(Config(interfaces))
+}
+
+// Render returns the cloud-init network configuration of the given
+// NetworkInterfaces as YAML. See render.Interfaces.
+func Render(
+	nis []v1alpha1.NetworkInterface,
+	networks []v1alpha1.Network,
+	vdns []v1alpha1.VSphereDistributedNetwork) ([]byte, error) {
+
+	interfaces, err := render.Interfaces(nis, networks, vdns)
+	if err != nil {
+		return nil, err
+	}
+	return Marshal(interfaces)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudinit_test
+
+import (
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render/cloudinit"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render/internal/rendertest"
+)
+
+func TestRender(t *testing.T) {
+	for _, tc := range rendertest.Cases() {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := cloudinit.Render(tc.Interfaces, tc.Networks, tc.VSphereDistributedNetworks)
+			if err != nil {
+				t.Fatalf("Render() = %v", err)
+			}
+			rendertest.Golden(t, tc.Name, ".yaml", got)
+		})
+	}
+}
+
+func TestRenderWithoutMacAddress(t *testing.T) {
+	nis := []v1alpha1.NetworkInterface{{}}
+	if _, err := cloudinit.Render(nis, nil, nil); err == nil {
+		t.Errorf("Render() of a NetworkInterface without a MAC address succeeded")
+	}
+}
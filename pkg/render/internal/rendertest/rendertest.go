@@ -0,0 +1,233 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rendertest provides the NetworkInterfaces, Networks and
+// VSphereDistributedNetworks rendered by the golden file tests of the
+// renderers, and the helpers to compare the rendered configuration with the
+// golden files.
+package rendertest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the renderers")
+
+// Case is the input of a golden file test.
+type Case struct {
+	// Name is the name of the golden file of the case in testdata, without
+	// its extension.
+	Name                       string
+	Interfaces                 []v1alpha1.NetworkInterface
+	Networks                   []v1alpha1.Network
+	VSphereDistributedNetworks []v1alpha1.VSphereDistributedNetwork
+}
+
+// Cases returns the inputs of the golden file tests.
+func Cases() []Case {
+	return []Case{
+		{
+			Name: "ipv4",
+			Interfaces: []v1alpha1.NetworkInterface{
+				networkInterface("ni", "network", "00:50:56:00:00:01", v1alpha1.IPConfig{
+					IP:         "192.168.1.10",
+					IPFamily:   "IPv4",
+					Gateway:    "192.168.1.1",
+					SubnetMask: "255.255.255.0",
+				}),
+			},
+			Networks: []v1alpha1.Network{network("network")},
+		},
+		{
+			Name: "ipv6",
+			Interfaces: []v1alpha1.NetworkInterface{
+				networkInterface("ni", "network", "00:50:56:00:00:01", v1alpha1.IPConfig{
+					IP:          "fd00::10",
+					IPFamily:    "IPv6",
+					Gateway:     "fd00::1",
+					SubnetMask:  "ffff:ffff:ffff:ffff::",
+					Nameservers: []string{"fd00::53"},
+				}),
+			},
+			Networks: []v1alpha1.Network{network("network")},
+		},
+		{
+			Name: "dualstack",
+			Interfaces: []v1alpha1.NetworkInterface{
+				networkInterface("ni", "network", "00:50:56:00:00:01",
+					v1alpha1.IPConfig{
+						IP:         "192.168.1.10",
+						IPFamily:   "IPv4",
+						Gateway:    "192.168.1.1",
+						SubnetMask: "255.255.255.0",
+					},
+					v1alpha1.IPConfig{
+						IP:         "fd00::10",
+						IPFamily:   "IPv6",
+						Gateway:    "fd00::1",
+						SubnetMask: "ffff:ffff:ffff:ffff::",
+					}),
+			},
+			Networks: []v1alpha1.Network{network("network")},
+		},
+		{
+			// The first interface has the default route, the second one
+			// only routes to the destinations of its routes, and the third
+			// one uses DHCP.
+			Name: "multinic",
+			Interfaces: []v1alpha1.NetworkInterface{
+				networkInterface("primary", "primary", "00:50:56:00:00:01", v1alpha1.IPConfig{
+					IP:         "192.168.1.10",
+					IPFamily:   "IPv4",
+					Gateway:    "192.168.1.1",
+					SubnetMask: "255.255.255.0",
+				}),
+				networkInterface("storage", "storage", "00:50:56:00:00:02", v1alpha1.IPConfig{
+					IP:         "10.10.1.10",
+					IPFamily:   "IPv4",
+					SubnetMask: "255.255.255.0",
+					Routes: []v1alpha1.Route{
+						{Destination: "10.20.0.0/16", NextHop: "10.10.1.1", Metric: 100},
+						{Destination: "10.30.0.0/16"},
+					},
+					Nameservers: []string{"10.10.1.53"},
+				}),
+				networkInterface("dhcp", "", "00:50:56:00:00:03"),
+			},
+			Networks: []v1alpha1.Network{network("primary"), network("storage")},
+		},
+		{
+			// IPv4 is assigned with DHCP and IPv6 from a static pool.
+			Name: "dhcp4-static6",
+			Interfaces: []v1alpha1.NetworkInterface{
+				networkInterface("ni", "network", "00:50:56:00:00:01", v1alpha1.IPConfig{
+					IP:         "fd00::10",
+					IPFamily:   "IPv6",
+					Gateway:    "fd00::1",
+					SubnetMask: "ffff:ffff:ffff:ffff::",
+				}),
+			},
+			Networks: []v1alpha1.Network{vdsNetwork("network", "vdn")},
+			VSphereDistributedNetworks: []v1alpha1.VSphereDistributedNetwork{
+				vsphereDistributedNetwork("vdn", v1alpha1.IPAssignmentModeDHCP, v1alpha1.IPAssignmentModeStaticPool),
+			},
+		},
+		{
+			// IPv4 is assigned from a static pool and IPv6 with SLAAC.
+			Name: "static4-slaac",
+			Interfaces: []v1alpha1.NetworkInterface{
+				networkInterface("ni", "network", "00:50:56:00:00:01", v1alpha1.IPConfig{
+					IP:         "192.168.1.10",
+					IPFamily:   "IPv4",
+					Gateway:    "192.168.1.1",
+					SubnetMask: "255.255.255.0",
+				}),
+			},
+			Networks: []v1alpha1.Network{vdsNetwork("network", "vdn")},
+			VSphereDistributedNetworks: []v1alpha1.VSphereDistributedNetwork{
+				vsphereDistributedNetwork("vdn", v1alpha1.IPAssignmentModeStaticPool, v1alpha1.IPAssignmentModeSLAAC),
+			},
+		},
+		{
+			// IPv4 is assigned with DHCP and IPv6 with DHCPv6.
+			Name:       "dhcp4-dhcpv6",
+			Interfaces: []v1alpha1.NetworkInterface{networkInterface("ni", "network", "00:50:56:00:00:01")},
+			Networks:   []v1alpha1.Network{vdsNetwork("network", "vdn")},
+			VSphereDistributedNetworks: []v1alpha1.VSphereDistributedNetwork{
+				vsphereDistributedNetwork("vdn", v1alpha1.IPAssignmentModeDHCP, v1alpha1.IPAssignmentModeDHCPv6),
+			},
+		},
+	}
+}
+
+func networkInterface(name, networkName, mac string, ipConfigs ...v1alpha1.IPConfig) v1alpha1.NetworkInterface {
+	return v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: networkName},
+		Status: v1alpha1.NetworkInterfaceStatus{
+			MacAddress: mac,
+			IPConfigs:  ipConfigs,
+		},
+	}
+}
+
+func network(name string) v1alpha1.Network {
+	return v1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: v1alpha1.NetworkSpec{
+			DNS:              []string{"192.168.1.53"},
+			DNSSearchDomains: []string{name + ".example.com"},
+			NTP:              []string{"ntp." + name + ".example.com"},
+		},
+	}
+}
+
+// vdsNetwork returns a Network provided by the named VSphereDistributedNetwork.
+func vdsNetwork(name, vdn string) v1alpha1.Network {
+	n := network(name)
+	n.Spec.Type = v1alpha1.NetworkTypeVDS
+	n.Spec.ProviderRef = v1alpha1.NetworkProviderReference{
+		APIGroup: v1alpha1.GroupName,
+		Kind:     "VSphereDistributedNetwork",
+		Name:     vdn,
+	}
+	return n
+}
+
+// vsphereDistributedNetwork returns a dual-stack VSphereDistributedNetwork
+// with the given assignment modes of IPv4 and IPv6 addresses.
+func vsphereDistributedNetwork(name string, mode4, mode6 v1alpha1.IPAssignmentModeType) v1alpha1.VSphereDistributedNetwork {
+	return v1alpha1.VSphereDistributedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.VSphereDistributedNetworkSpec{
+			PortGroupID:      "dvportgroup-1",
+			IPAssignmentMode: mode4,
+			IPv6:             &v1alpha1.IPv6Config{IPAssignmentMode: mode6},
+		},
+	}
+}
+
+// FilesContents returns the paths, modes and contents of the given files as a
+// single document, to compare with a golden file.
+func FilesContents(files []render.File) []byte {
+	var b bytes.Buffer
+	for i, f := range files {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "# %s (%04o)\n", f.Path, f.Mode)
+		b.Write(f.Contents)
+	}
+	return b.Bytes()
+}
+
+// Golden compares got with the golden file testdata/<name><ext>, or updates
+// the golden file if the tests are run with -update.
+func Golden(t *testing.T, name, ext string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name+ext)
+	if *update {
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s does not match the rendered configuration, run the tests with -update to update it:\n%s",
+			path, got)
+	}
+}
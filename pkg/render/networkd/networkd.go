@@ -0,0 +1,130 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package networkd renders the network configuration of a guest as
+// systemd-networkd .link and .network units, and its NTP servers as a
+// systemd-timesyncd drop-in.
+package networkd
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render"
+)
+
+const (
+	// NetworkDir is the directory of the .link and .network units.
+	NetworkDir = "/etc/systemd/network"
+
+	// TimesyncdPath is the path of the systemd-timesyncd drop-in.
+	TimesyncdPath = "/etc/systemd/timesyncd.conf.d/net-operator.conf"
+
+	// FileMode is the mode of the rendered files.
+	FileMode = 0644
+
+	// unitPrefix orders the units before the ones shipped with the guest.
+	unitPrefix = "10-"
+)
+
+// Files returns a .link and a .network unit for each of the given interfaces,
+// followed by a systemd-timesyncd drop-in if the interfaces have NTP servers.
+// The units match the interfaces by their MAC address.
+func Files(interfaces []render.Interface) []render.File {
+	files := make([]render.File, 0, 2*len(interfaces)+1)
+	for _, iface := range interfaces {
+		name := path.Join(NetworkDir, unitPrefix+iface.Name)
+		files = append(files,
+			render.File{Path: name + ".link", Mode: FileMode, Contents: []byte(Link(iface))},
+			render.File{Path: name + ".network", Mode: FileMode, Contents: []byte(Network(iface))},
+		)
+	}
+	if servers := render.NTPServers(interfaces); len(servers) > 0 {
+		files = append(files, render.File{
+			Path:     TimesyncdPath,
+			Mode:     FileMode,
+			Contents: []byte(Timesyncd(servers)),
+		})
+	}
+	return files
+}
+
+// Link returns the .link unit that names the given interface.
+func Link(iface render.Interface) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[Match]\n")
+	fmt.Fprintf(&b, "MACAddress=%s\n", iface.MacAddress)
+	fmt.Fprintf(&b, "\n[Link]\n")
+	fmt.Fprintf(&b, "Name=%s\n", iface.Name)
+	return b.String()
+}
+
+// Network returns the .network unit that configures the given interface.
+func Network(iface render.Interface) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[Match]\n")
+	fmt.Fprintf(&b, "MACAddress=%s\n", iface.MacAddress)
+	fmt.Fprintf(&b, "\n[Network]\n")
+	switch {
+	case iface.DHCP4 && iface.DHCP6:
+		fmt.Fprintf(&b, "DHCP=yes\n")
+	case iface.DHCP4:
+		fmt.Fprintf(&b, "DHCP=ipv4\n")
+	case iface.DHCP6:
+		fmt.Fprintf(&b, "DHCP=ipv6\n")
+	}
+	if iface.AcceptRA {
+		fmt.Fprintf(&b, "IPv6AcceptRA=yes\n")
+	}
+	for _, addr := range iface.Addresses {
+		fmt.Fprintf(&b, "Address=%s\n", addr.CIDR())
+	}
+	if gw := iface.Gateway4(); gw != nil {
+		fmt.Fprintf(&b, "Gateway=%s\n", gw)
+	}
+	if gw := iface.Gateway6(); gw != nil {
+		fmt.Fprintf(&b, "Gateway=%s\n", gw)
+	}
+	for _, s := range iface.Nameservers {
+		fmt.Fprintf(&b, "DNS=%s\n", s)
+	}
+	if len(iface.SearchDomains) > 0 {
+		fmt.Fprintf(&b, "Domains=%s\n", strings.Join(iface.SearchDomains, " "))
+	}
+	for _, r := range iface.Routes {
+		fmt.Fprintf(&b, "\n[Route]\n")
+		fmt.Fprintf(&b, "Destination=%s\n", r.Destination)
+		if r.NextHop != nil {
+			fmt.Fprintf(&b, "Gateway=%s\n", r.NextHop)
+		}
+		if r.Metric != 0 {
+			fmt.Fprintf(&b, "Metric=%d\n", r.Metric)
+		}
+	}
+	return b.String()
+}
+
+// Timesyncd returns the systemd-timesyncd drop-in that sets the given NTP
+// servers.
+func Timesyncd(servers []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[Time]\n")
+	fmt.Fprintf(&b, "NTP=%s\n", strings.Join(servers, " "))
+	return b.String()
+}
+
+// Render returns the units of the given NetworkInterfaces and the
+// systemd-timesyncd drop-in of their Networks. See render.Interfaces.
+func Render(
+	nis []v1alpha1.NetworkInterface,
+	networks []v1alpha1.Network,
+	vdns []v1alpha1.VSphereDistributedNetwork) ([]render.File, error) {
+
+	interfaces, err := render.Interfaces(nis, networks, vdns)
+	if err != nil {
+		return nil, err
+	}
+	return Files(interfaces), nil
+}
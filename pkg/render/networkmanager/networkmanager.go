@@ -0,0 +1,167 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package networkmanager renders the network configuration of a guest as
+// NetworkManager keyfile connection profiles.
+package networkmanager
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render"
+)
+
+const (
+	// ConnectionsDir is the directory of the keyfile connection profiles.
+	ConnectionsDir = "/etc/NetworkManager/system-connections"
+
+	// FileMode is the mode of the connection profiles. NetworkManager ignores
+	// profiles that are readable by other users than root.
+	FileMode = 0600
+)
+
+// Files returns a connection profile for each of the given interfaces. The
+// profiles match the interfaces by their MAC address.
+func Files(interfaces []render.Interface) []render.File {
+	files := make([]render.File, 0, len(interfaces))
+	for _, iface := range interfaces {
+		files = append(files, render.File{
+			Path:     path.Join(ConnectionsDir, iface.Name+".nmconnection"),
+			Mode:     FileMode,
+			Contents: []byte(Connection(iface)),
+		})
+	}
+	return files
+}
+
+// Connection returns the keyfile connection profile of the given interface.
+// IPv6 obtained from router advertisements uses the automatic method, which
+// uses SLAAC or DHCPv6 as advertised. A family without static addresses that
+// is not configured automatically is disabled.
+func Connection(iface render.Interface) string {
+	var ipv4, ipv6 []render.Address
+	for _, addr := range iface.Addresses {
+		if addr.IsIPv6() {
+			ipv6 = append(ipv6, addr)
+		} else {
+			ipv4 = append(ipv4, addr)
+		}
+	}
+	var routes4, routes6 []render.Route
+	for _, r := range iface.Routes {
+		if r.IsIPv6() {
+			routes6 = append(routes6, r)
+		} else {
+			routes4 = append(routes4, r)
+		}
+	}
+	var dns4, dns6 []string
+	for _, s := range iface.Nameservers {
+		if ip := net.ParseIP(s); ip != nil && ip.To4() == nil {
+			dns6 = append(dns6, s)
+		} else {
+			dns4 = append(dns4, s)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[connection]\n")
+	fmt.Fprintf(&b, "id=%s\n", iface.Name)
+	fmt.Fprintf(&b, "type=ethernet\n")
+	fmt.Fprintf(&b, "\n[ethernet]\n")
+	fmt.Fprintf(&b, "mac-address=%s\n", strings.ToUpper(iface.MacAddress))
+
+	method4 := "disabled"
+	if iface.DHCP4 {
+		method4 = "auto"
+	}
+	method6 := "ignore"
+	switch {
+	case iface.DHCP6:
+		method6 = "dhcp"
+	case iface.AcceptRA:
+		method6 = "auto"
+	}
+
+	fmt.Fprintf(&b, "\n[ipv4]\n")
+	writeIPSettings(&b, iface, ipv4, routes4, iface.Gateway4(), dns4, method4)
+	fmt.Fprintf(&b, "\n[ipv6]\n")
+	writeIPSettings(&b, iface, ipv6, routes6, iface.Gateway6(), dns6, method6)
+	return b.String()
+}
+
+// writeIPSettings writes the settings of the [ipv4] or [ipv6] section.
+// method is the method of a family without static addresses, the settings of
+// a disabled family are omitted.
+func writeIPSettings(
+	b *strings.Builder,
+	iface render.Interface,
+	addresses []render.Address,
+	routes []render.Route,
+	gateway net.IP,
+	dns []string,
+	method string) {
+
+	if len(addresses) > 0 {
+		method = "manual"
+	}
+	fmt.Fprintf(b, "method=%s\n", method)
+	if method == "disabled" || method == "ignore" {
+		return
+	}
+	for i, addr := range addresses {
+		fmt.Fprintf(b, "address%d=%s\n", i+1, addr.CIDR())
+	}
+	if gateway != nil {
+		fmt.Fprintf(b, "gateway=%s\n", gateway)
+	}
+	for i, r := range routes {
+		fmt.Fprintf(b, "route%d=%s\n", i+1, routeValue(r))
+	}
+	if len(dns) > 0 {
+		fmt.Fprintf(b, "dns=%s;\n", strings.Join(dns, ";"))
+	}
+	if len(iface.SearchDomains) > 0 {
+		fmt.Fprintf(b, "dns-search=%s;\n", strings.Join(iface.SearchDomains, ";"))
+	}
+}
+
+// routeValue returns the value of a routeN setting, the destination of the
+// route followed by its next hop and metric if set. A route with a metric and
+// without a next hop has the unspecified address as next hop.
+func routeValue(r render.Route) string {
+	value := r.Destination.String()
+	if r.NextHop == nil && r.Metric == 0 {
+		return value
+	}
+	hop := r.NextHop
+	if hop == nil {
+		hop = net.IPv4zero
+		if r.IsIPv6() {
+			hop = net.IPv6unspecified
+		}
+	}
+	value += "," + hop.String()
+	if r.Metric != 0 {
+		value += fmt.Sprintf(",%d", r.Metric)
+	}
+	return value
+}
+
+// Render returns the connection profiles of the given NetworkInterfaces. See
+// render.Interfaces.
+func Render(
+	nis []v1alpha1.NetworkInterface,
+	networks []v1alpha1.Network,
+	vdns []v1alpha1.VSphereDistributedNetwork) ([]render.File, error) {
+
+	interfaces, err := render.Interfaces(nis, networks, vdns)
+	if err != nil {
+		return nil, err
+	}
+	return Files(interfaces), nil
+}
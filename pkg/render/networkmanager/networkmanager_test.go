@@ -0,0 +1,23 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package networkmanager_test
+
+import (
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/pkg/render/internal/rendertest"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render/networkmanager"
+)
+
+func TestRender(t *testing.T) {
+	for _, tc := range rendertest.Cases() {
+		t.Run(tc.Name, func(t *testing.T) {
+			files, err := networkmanager.Render(tc.Interfaces, tc.Networks, tc.VSphereDistributedNetworks)
+			if err != nil {
+				t.Fatalf("Render() = %v", err)
+			}
+			rendertest.Golden(t, tc.Name, ".golden", rendertest.FilesContents(files))
+		})
+	}
+}
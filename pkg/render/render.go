@@ -0,0 +1,343 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package render converts NetworkInterfaces and their Networks into a guest
+// independent description of the network configuration of a guest. The
+// packages below render translate that description into the configuration
+// formats understood by the guests' network managers.
+package render
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// InterfaceNamePrefix is the prefix of the guest names of interfaces. The
+// interfaces are named in the order of the NetworkInterfaces, ex. eth0, eth1.
+const InterfaceNamePrefix = "eth"
+
+// Interface is the configuration of a network interface in the guest.
+type Interface struct {
+	// Name is the name of the interface in the guest, ex. eth0.
+	Name string
+	// MacAddress is the MAC address used to match the interface in the
+	// guest.
+	MacAddress string
+	// DHCP4 is true if the interface has no static IPv4 address and obtains
+	// its IPv4 address with DHCP.
+	DHCP4 bool
+	// DHCP6 is true if the interface has no static IPv6 address and obtains
+	// its IPv6 address with DHCPv6.
+	DHCP6 bool
+	// AcceptRA is true if the interface has no static IPv6 address and
+	// configures IPv6 from router advertisements, with SLAAC or DHCPv6 as
+	// advertised by the routers.
+	AcceptRA bool
+	// Addresses are the static addresses of the interface.
+	Addresses []Address
+	// Routes are the static routes of the interface, in addition to the
+	// default routes through the gateways of its addresses.
+	Routes []Route
+	// Nameservers are the addresses of the DNS servers of the interface.
+	Nameservers []string
+	// SearchDomains are the DNS search domains of the interface.
+	SearchDomains []string
+	// NTP are the NTP servers of the network of the interface.
+	NTP []string
+}
+
+// Address is a static address of an interface.
+type Address struct {
+	// IP is the address.
+	IP net.IP
+	// PrefixLength is the length of the prefix of the address's subnet.
+	PrefixLength int
+	// Gateway is the default gateway of the address's family, or nil if the
+	// address has no gateway.
+	Gateway net.IP
+}
+
+// Route is a static route of an interface.
+type Route struct {
+	// Destination is the destination prefix of the route.
+	Destination *net.IPNet
+	// NextHop is the router of the route, or nil if the destination is
+	// directly reachable on the link.
+	NextHop net.IP
+	// Metric is the metric of the route, or 0 for the default metric.
+	Metric int32
+}
+
+// IsIPv6 returns true if the route is an IPv6 route.
+func (r Route) IsIPv6() bool {
+	return r.Destination.IP.To4() == nil
+}
+
+// IsIPv6 returns true if the address is an IPv6 address.
+func (a Address) IsIPv6() bool {
+	return a.IP.To4() == nil
+}
+
+// CIDR returns the address and its prefix length in CIDR notation, ex.
+// 192.168.1.10/24.
+func (a Address) CIDR() string {
+	return fmt.Sprintf("%s/%d", a.IP, a.PrefixLength)
+}
+
+// Gateway4 returns the IPv4 gateway of the interface, or nil if it does not
+// have one.
+func (i Interface) Gateway4() net.IP {
+	return i.gateway(false)
+}
+
+// Gateway6 returns the IPv6 gateway of the interface, or nil if it does not
+// have one.
+func (i Interface) Gateway6() net.IP {
+	return i.gateway(true)
+}
+
+func (i Interface) gateway(ipv6 bool) net.IP {
+	for _, a := range i.Addresses {
+		if a.IsIPv6() == ipv6 && a.Gateway != nil {
+			return a.Gateway
+		}
+	}
+	return nil
+}
+
+// Interfaces returns the guest configuration of the given NetworkInterfaces.
+// The search domains and NTP servers of an interface come from the Network
+// named by its NetworkName, which must be among networks, and its nameservers
+// are those of its IPConfigs followed by those of the Network. The
+// NetworkInterfaces must have been realized, i.e. have a MAC address.
+//
+// The families of an interface without static addresses are configured by
+// the assignment modes of the VSphereDistributedNetwork of its Network, if it
+// is among vdns. An interface whose Network has another provider, or that has
+// no Network, uses DHCP for IPv4 and router advertisements for IPv6 if it has
+// no static addresses.
+func Interfaces(
+	nis []v1alpha1.NetworkInterface,
+	networks []v1alpha1.Network,
+	vdns []v1alpha1.VSphereDistributedNetwork) ([]Interface, error) {
+
+	interfaces := make([]Interface, 0, len(nis))
+	for i := range nis {
+		ni := &nis[i]
+		if ni.Status.MacAddress == "" {
+			return nil, fmt.Errorf("NetworkInterface %s/%s does not have a MAC address", ni.Namespace, ni.Name)
+		}
+		iface := Interface{
+			Name:       fmt.Sprintf("%s%d", InterfaceNamePrefix, i),
+			MacAddress: ni.Status.MacAddress,
+		}
+
+		for _, c := range ni.Status.IPConfigs {
+			addr, err := address(c)
+			if err != nil {
+				return nil, fmt.Errorf("NetworkInterface %s/%s: %v", ni.Namespace, ni.Name, err)
+			}
+			iface.Addresses = append(iface.Addresses, addr)
+			for _, r := range c.Routes {
+				route, err := parseRoute(r)
+				if err != nil {
+					return nil, fmt.Errorf("NetworkInterface %s/%s: %v", ni.Namespace, ni.Name, err)
+				}
+				iface.Routes = append(iface.Routes, route)
+			}
+			iface.Nameservers = appendMissing(iface.Nameservers, c.Nameservers...)
+		}
+
+		var vdn *v1alpha1.VSphereDistributedNetwork
+		if ni.Spec.NetworkName != "" {
+			network := findNetwork(networks, ni.Namespace, ni.Spec.NetworkName)
+			if network == nil {
+				return nil, fmt.Errorf("network %s/%s of NetworkInterface %s not found",
+					ni.Namespace, ni.Spec.NetworkName, ni.Name)
+			}
+			iface.Nameservers = appendMissing(iface.Nameservers, network.Spec.DNS...)
+			iface.SearchDomains = network.Spec.DNSSearchDomains
+			iface.NTP = network.Spec.NTP
+			vdn = findVSphereDistributedNetwork(vdns, network)
+		}
+		setAssignmentModes(&iface, vdn)
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces, nil
+}
+
+func findNetwork(networks []v1alpha1.Network, namespace, name string) *v1alpha1.Network {
+	for i := range networks {
+		if networks[i].Namespace == namespace && networks[i].Name == name {
+			return &networks[i]
+		}
+	}
+	return nil
+}
+
+// findVSphereDistributedNetwork returns the VSphereDistributedNetwork that
+// provides the network, or nil if it is not among vdns.
+func findVSphereDistributedNetwork(
+	vdns []v1alpha1.VSphereDistributedNetwork,
+	network *v1alpha1.Network) *v1alpha1.VSphereDistributedNetwork {
+
+	ref := network.Spec.ProviderRef
+	if ref.APIGroup != v1alpha1.GroupName || ref.Kind != "VSphereDistributedNetwork" {
+		return nil
+	}
+	for i := range vdns {
+		if vdns[i].Name == ref.Name {
+			return &vdns[i]
+		}
+	}
+	return nil
+}
+
+// setAssignmentModes sets how the families of the interface without static
+// addresses are configured, from the assignment modes of vdn. vdn is nil if
+// the network of the interface is not a VSphereDistributedNetwork.
+func setAssignmentModes(iface *Interface, vdn *v1alpha1.VSphereDistributedNetwork) {
+	var static4, static6 bool
+	for _, a := range iface.Addresses {
+		if a.IsIPv6() {
+			static6 = true
+		} else {
+			static4 = true
+		}
+	}
+	if vdn == nil {
+		if !static4 && !static6 {
+			iface.DHCP4, iface.AcceptRA = true, true
+		}
+		return
+	}
+	iface.DHCP4 = !static4 && vdn.Spec.IPAssignmentMode == v1alpha1.IPAssignmentModeDHCP
+	if v6 := vdn.Spec.IPv6; v6 != nil && !static6 {
+		iface.DHCP6 = v6.IPAssignmentMode == v1alpha1.IPAssignmentModeDHCPv6
+		iface.AcceptRA = v6.IPAssignmentMode == v1alpha1.IPAssignmentModeSLAAC
+	}
+}
+
+// address converts an IPConfig to an Address. An empty subnet mask is a
+// single address prefix.
+func address(c v1alpha1.IPConfig) (Address, error) {
+	ip := net.ParseIP(c.IP)
+	if ip == nil {
+		return Address{}, fmt.Errorf("invalid IP address %q", c.IP)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	addr := Address{IP: ip}
+
+	prefixLength, err := PrefixLength(c.SubnetMask, len(ip)*8)
+	if err != nil {
+		return Address{}, err
+	}
+	addr.PrefixLength = prefixLength
+
+	if c.Gateway != "" {
+		gw := net.ParseIP(c.Gateway)
+		if gw == nil {
+			return Address{}, fmt.Errorf("invalid gateway %q", c.Gateway)
+		}
+		if (gw.To4() == nil) != addr.IsIPv6() {
+			return Address{}, fmt.Errorf("gateway %q is not in the family of address %q", c.Gateway, c.IP)
+		}
+		if gw4 := gw.To4(); gw4 != nil {
+			gw = gw4
+		}
+		addr.Gateway = gw
+	}
+	return addr, nil
+}
+
+// parseRoute converts a static route of an IPConfig to a Route.
+func parseRoute(r v1alpha1.Route) (Route, error) {
+	_, dest, err := net.ParseCIDR(r.Destination)
+	if err != nil {
+		return Route{}, fmt.Errorf("invalid route destination %q", r.Destination)
+	}
+	route := Route{Destination: dest, Metric: r.Metric}
+	if r.NextHop != "" {
+		hop := net.ParseIP(r.NextHop)
+		if hop == nil {
+			return Route{}, fmt.Errorf("invalid next hop %q of route to %s", r.NextHop, r.Destination)
+		}
+		if hop4 := hop.To4(); hop4 != nil {
+			hop = hop4
+		}
+		if (len(hop) == net.IPv4len) != (len(dest.IP) == net.IPv4len) {
+			return Route{}, fmt.Errorf("next hop %q is not in the family of route destination %q", r.NextHop, r.Destination)
+		}
+		route.NextHop = hop
+	}
+	return route, nil
+}
+
+// appendMissing appends the values that s does not contain yet to s.
+func appendMissing(s []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, e := range s {
+			if e == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			s = append(s, v)
+		}
+	}
+	return s
+}
+
+// PrefixLength returns the prefix length of the given subnet mask, ex. 24 for
+// 255.255.255.0, for an address of the given number of bits. An empty mask is
+// a single address prefix.
+func PrefixLength(subnetMask string, bits int) (int, error) {
+	if subnetMask == "" {
+		return bits, nil
+	}
+	mask := net.ParseIP(subnetMask)
+	if mask != nil && bits == 32 {
+		mask = mask.To4()
+	}
+	if mask == nil {
+		return 0, fmt.Errorf("invalid subnet mask %q", subnetMask)
+	}
+	ones, maskBits := net.IPMask(mask).Size()
+	if maskBits != bits {
+		return 0, fmt.Errorf("invalid subnet mask %q", subnetMask)
+	}
+	return ones, nil
+}
+
+// File is a configuration file rendered for a guest.
+type File struct {
+	// Path is the absolute path of the file in the guest.
+	Path string
+	// Mode is the permissions of the file.
+	Mode os.FileMode
+	// Contents is the contents of the file.
+	Contents []byte
+}
+
+// NTPServers returns the NTP servers of the given interfaces, without
+// duplicates, in the order of the interfaces.
+func NTPServers(interfaces []Interface) []string {
+	var servers []string
+	seen := map[string]bool{}
+	for _, iface := range interfaces {
+		for _, s := range iface.NTP {
+			if !seen[s] {
+				seen[s] = true
+				servers = append(servers, s)
+			}
+		}
+	}
+	return servers
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import "testing"
+
+func TestPrefixLength(t *testing.T) {
+	tests := []struct {
+		mask string
+		bits int
+		want int
+		ok   bool
+	}{
+		{"255.255.255.0", 32, 24, true},
+		{"255.255.255.255", 32, 32, true},
+		{"", 32, 32, true},
+		{"ffff:ffff:ffff:ffff::", 128, 64, true},
+		{"", 128, 128, true},
+		{"255.0.255.0", 32, 0, false},
+		{"255.255.255.0", 128, 0, false},
+		{"mask", 32, 0, false},
+	}
+	for _, tt := range tests {
+		got, err := PrefixLength(tt.mask, tt.bits)
+		if got != tt.want || (err == nil) != tt.ok {
+			t.Errorf("PrefixLength(%q, %d) = %d, %v, want %d, ok %v", tt.mask, tt.bits, got, err, tt.want, tt.ok)
+		}
+	}
+}
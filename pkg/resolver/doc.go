@@ -0,0 +1,14 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resolver resolves the provider references of Networks,
+// NetworkInterfaces and LoadBalancerConfigs to the objects they refer to.
+//
+// A provider reference is a loosely typed APIGroup, Kind and Name tuple. A
+// Resolver looks up the kind of the reference among the providers supported
+// for the referencing object, gets the object with a controller-runtime client
+// and returns it as its concrete type, ex. a *v1alpha1.VSphereDistributedNetwork
+// for a Network. The errors returned for unsupported kinds, unsupported API
+// versions and missing objects are typed, see UnknownKindError,
+// VersionMismatchError and NotFoundError.
+package resolver
@@ -0,0 +1,87 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ErrNoProviderRef is returned when resolving the provider of an object that
+// does not reference one.
+var ErrNoProviderRef = errors.New("object does not have a provider reference")
+
+// UnknownKindError is returned when a reference refers to a kind that is not
+// a supported provider for the referencing object.
+type UnknownKindError struct {
+	// GroupKind is the group and kind of the reference.
+	GroupKind schema.GroupKind
+}
+
+func (e *UnknownKindError) Error() string {
+	return fmt.Sprintf("unsupported provider kind %s", e.GroupKind)
+}
+
+// VersionMismatchError is returned when a reference specifies an API version
+// that is not served for the kind it refers to.
+type VersionMismatchError struct {
+	// GroupKind is the group and kind of the reference.
+	GroupKind schema.GroupKind
+	// APIVersion is the API version of the reference.
+	APIVersion string
+	// Supported are the supported versions of the kind.
+	Supported []string
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("unsupported API version %q for provider kind %s, supported versions are %s",
+		e.APIVersion, e.GroupKind, strings.Join(e.Supported, ", "))
+}
+
+// NotFoundError is returned when the object a reference refers to does not
+// exist.
+type NotFoundError struct {
+	// GroupKind is the group and kind of the reference.
+	GroupKind schema.GroupKind
+	// Namespace is the namespace of the object, empty for cluster scoped
+	// kinds.
+	Namespace string
+	// Name is the name of the object.
+	Name string
+	// Err is the error returned by the client.
+	Err error
+}
+
+func (e *NotFoundError) Error() string {
+	name := e.Name
+	if e.Namespace != "" {
+		name = e.Namespace + "/" + name
+	}
+	return fmt.Sprintf("provider %s %s not found", e.GroupKind, name)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// IsUnknownKind returns true if err is or wraps an UnknownKindError.
+func IsUnknownKind(err error) bool {
+	var e *UnknownKindError
+	return errors.As(err, &e)
+}
+
+// IsVersionMismatch returns true if err is or wraps a VersionMismatchError.
+func IsVersionMismatch(err error) bool {
+	var e *VersionMismatchError
+	return errors.As(err, &e)
+}
+
+// IsNotFound returns true if err is or wraps a NotFoundError.
+func IsNotFound(err error) bool {
+	var e *NotFoundError
+	return errors.As(err, &e)
+}
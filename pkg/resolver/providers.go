@@ -0,0 +1,115 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resolver
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// defaultVersion is the version an object is resolved to when its reference
+// does not specify an API version.
+var defaultVersion = v1alpha1.SchemeGroupVersion.Version
+
+// provider describes a kind that may be referenced as a provider.
+type provider struct {
+	// namespaced is true if the kind is namespace scoped.
+	namespaced bool
+	// versions returns an empty object of the kind for each served version.
+	versions map[string]func() runtime.Object
+}
+
+// providers are the kinds that may be referenced as a provider by an object.
+type providers map[schema.GroupKind]provider
+
+var networkProviders = providers{
+	groupKind("NSXTNetwork"): {
+		versions: map[string]func() runtime.Object{
+			v1alpha1.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha1.NSXTNetwork{} },
+			v1alpha2.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha2.NSXTNetwork{} },
+		},
+	},
+	groupKind("VSphereDistributedNetwork"): {
+		versions: map[string]func() runtime.Object{
+			v1alpha1.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha1.VSphereDistributedNetwork{} },
+			v1alpha2.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha2.VSphereDistributedNetwork{} },
+		},
+	},
+}
+
+var networkInterfaceProviders = providers{
+	groupKind("E1000ENetworkInterface"): {
+		namespaced: true,
+		versions: map[string]func() runtime.Object{
+			v1alpha1.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha1.E1000ENetworkInterface{} },
+			v1alpha2.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha2.E1000ENetworkInterface{} },
+		},
+	},
+	groupKind("SRIOVNetworkInterface"): {
+		namespaced: true,
+		versions: map[string]func() runtime.Object{
+			v1alpha1.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha1.SRIOVNetworkInterface{} },
+			v1alpha2.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha2.SRIOVNetworkInterface{} },
+		},
+	},
+	groupKind("VMXNET3NetworkInterface"): {
+		namespaced: true,
+		versions: map[string]func() runtime.Object{
+			v1alpha1.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha1.VMXNET3NetworkInterface{} },
+			v1alpha2.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha2.VMXNET3NetworkInterface{} },
+		},
+	},
+}
+
+var loadBalancerProviders = providers{
+	groupKind("AviLoadBalancerConfig"): {
+		versions: map[string]func() runtime.Object{
+			v1alpha1.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha1.AviLoadBalancerConfig{} },
+			v1alpha2.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha2.AviLoadBalancerConfig{} },
+		},
+	},
+	groupKind("HAProxyLoadBalancerConfig"): {
+		versions: map[string]func() runtime.Object{
+			v1alpha1.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha1.HAProxyLoadBalancerConfig{} },
+			v1alpha2.SchemeGroupVersion.Version: func() runtime.Object { return &v1alpha2.HAProxyLoadBalancerConfig{} },
+		},
+	},
+}
+
+func groupKind(kind string) schema.GroupKind {
+	return schema.GroupKind{Group: v1alpha1.GroupName, Kind: kind}
+}
+
+// newObject returns an empty object of the given kind for the given API
+// version, which may be a version or a group/version. The default version is
+// used if apiVersion is empty.
+func (p provider) newObject(gk schema.GroupKind, apiVersion string) (runtime.Object, error) {
+	version := defaultVersion
+	if apiVersion != "" {
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil || (gv.Group != "" && gv.Group != gk.Group) {
+			return nil, p.versionMismatch(gk, apiVersion)
+		}
+		version = gv.Version
+	}
+	newFn, ok := p.versions[version]
+	if !ok {
+		return nil, p.versionMismatch(gk, apiVersion)
+	}
+	return newFn(), nil
+}
+
+func (p provider) versionMismatch(gk schema.GroupKind, apiVersion string) error {
+	supported := make([]string, 0, len(p.versions))
+	for v := range p.versions {
+		supported = append(supported, v)
+	}
+	sort.Strings(supported)
+	return &VersionMismatchError{GroupKind: gk, APIVersion: apiVersion, Supported: supported}
+}
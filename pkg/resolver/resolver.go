@@ -0,0 +1,114 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resolver
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// Resolver resolves provider references with a controller-runtime client. The
+// scheme of the client must include the API versions the references resolve
+// to, v1alpha1 unless a reference specifies another version.
+type Resolver struct {
+	client client.Reader
+}
+
+// New returns a Resolver that gets the referenced objects with c.
+func New(c client.Reader) *Resolver {
+	return &Resolver{client: c}
+}
+
+// reference is a kind independent provider reference.
+type reference struct {
+	APIGroup   string
+	APIVersion string
+	Kind       string
+	Name       string
+	Namespace  string
+}
+
+// NetworkProvider returns the provider of the given Network. The provider is
+// a *NSXTNetwork or a *VSphereDistributedNetwork of the version specified by
+// the reference.
+func (r *Resolver) NetworkProvider(ctx context.Context, n *v1alpha1.Network) (runtime.Object, error) {
+	ref := n.Spec.ProviderRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = n.Namespace
+	}
+	return r.resolve(ctx, networkProviders, reference{
+		APIGroup:   ref.APIGroup,
+		APIVersion: ref.APIVersion,
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+		Namespace:  namespace,
+	})
+}
+
+// NetworkInterfaceProvider returns the provider of the given
+// NetworkInterface. The provider is a *VMXNET3NetworkInterface,
+// *E1000ENetworkInterface or *SRIOVNetworkInterface of the version specified
+// by the reference, in the namespace of the NetworkInterface.
+// ErrNoProviderRef is returned if the NetworkInterface does not reference a
+// provider.
+func (r *Resolver) NetworkInterfaceProvider(ctx context.Context, ni *v1alpha1.NetworkInterface) (runtime.Object, error) {
+	ref := ni.Spec.ProviderRef
+	if ref == nil {
+		return nil, ErrNoProviderRef
+	}
+	return r.resolve(ctx, networkInterfaceProviders, reference{
+		APIGroup:   ref.APIGroup,
+		APIVersion: ref.APIVersion,
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+		Namespace:  ni.Namespace,
+	})
+}
+
+// LoadBalancerProvider returns the provider of the given LoadBalancerConfig.
+// The provider is an *AviLoadBalancerConfig or a *HAProxyLoadBalancerConfig
+// of the version specified by the reference.
+func (r *Resolver) LoadBalancerProvider(ctx context.Context, lb *v1alpha1.LoadBalancerConfig) (runtime.Object, error) {
+	ref := lb.Spec.ProviderRef
+	return r.resolve(ctx, loadBalancerProviders, reference{
+		APIGroup:   ref.APIGroup,
+		APIVersion: ref.APIVersion,
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+	})
+}
+
+// resolve gets the object ref refers to, which must be one of the supported
+// providers. The namespace of the reference is ignored for cluster scoped
+// kinds.
+func (r *Resolver) resolve(ctx context.Context, supported providers, ref reference) (runtime.Object, error) {
+	gk := schema.GroupKind{Group: ref.APIGroup, Kind: ref.Kind}
+	p, ok := supported[gk]
+	if !ok {
+		return nil, &UnknownKindError{GroupKind: gk}
+	}
+	obj, err := p.newObject(gk, ref.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	key := client.ObjectKey{Name: ref.Name}
+	if p.namespaced {
+		key.Namespace = ref.Namespace
+	}
+	if err := r.client.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &NotFoundError{GroupKind: gk, Namespace: key.Namespace, Name: key.Name, Err: err}
+		}
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resolver_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/internal/testenv"
+	"github.com/vmware-tanzu/net-operator-api/pkg/resolver"
+)
+
+func providers() []runtime.Object {
+	return []runtime.Object{
+		&v1alpha1.VSphereDistributedNetwork{ObjectMeta: metav1.ObjectMeta{Name: "vdn"}},
+		&v1alpha1.VMXNET3NetworkInterface{ObjectMeta: metav1.ObjectMeta{Name: "vmxnet3", Namespace: "ns"}},
+		&v1alpha1.E1000ENetworkInterface{ObjectMeta: metav1.ObjectMeta{Name: "e1000e", Namespace: "ns"}},
+		&v1alpha1.SRIOVNetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "sriov", Namespace: "ns"},
+			Spec:       v1alpha1.SRIOVNetworkInterfaceSpec{PhysicalFunction: "0000:3b:00.0"},
+		},
+		&v1alpha1.HAProxyLoadBalancerConfig{ObjectMeta: metav1.ObjectMeta{Name: "haproxy"}},
+	}
+}
+
+func newFakeClient(t *testing.T, objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewFakeClientWithScheme(scheme, objs...)
+}
+
+func TestResolver(t *testing.T) {
+	testResolver(t, resolver.New(newFakeClient(t, providers()...)), false)
+}
+
+func TestResolverEnvtest(t *testing.T) {
+	env := testenv.Start(t, testenv.Options{})
+	defer env.Stop(t)
+	for _, obj := range providers() {
+		if err := env.Client.Create(context.Background(), obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	testResolver(t, resolver.New(env.Client), true)
+}
+
+// testResolver tests r against the objects returned by providers. Only API
+// servers serve the objects in every version, fake clients only serve them in
+// the version they were created with.
+func testResolver(t *testing.T, r *resolver.Resolver, apiServer bool) {
+	ctx := context.Background()
+
+	network := &v1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: "ns"},
+		Spec: v1alpha1.NetworkSpec{
+			ProviderRef: v1alpha1.NetworkProviderReference{
+				APIGroup: v1alpha1.GroupName,
+				Kind:     "VSphereDistributedNetwork",
+				Name:     "vdn",
+			},
+		},
+	}
+	obj, err := r.NetworkProvider(ctx, network)
+	if err != nil {
+		t.Fatalf("NetworkProvider() = %v", err)
+	}
+	if vdn, ok := obj.(*v1alpha1.VSphereDistributedNetwork); !ok || vdn.Name != "vdn" {
+		t.Errorf("NetworkProvider() = %#v, want the v1alpha1 VSphereDistributedNetwork vdn", obj)
+	}
+
+	if apiServer {
+		network.Spec.ProviderRef.APIVersion = v1alpha2.SchemeGroupVersion.Version
+		obj, err = r.NetworkProvider(ctx, network)
+		if err != nil {
+			t.Fatalf("NetworkProvider() of v1alpha2 = %v", err)
+		}
+		if vdn, ok := obj.(*v1alpha2.VSphereDistributedNetwork); !ok || vdn.Name != "vdn" {
+			t.Errorf("NetworkProvider() of v1alpha2 = %#v, want the v1alpha2 VSphereDistributedNetwork vdn", obj)
+		}
+	}
+
+	network.Spec.ProviderRef.APIVersion = "v1"
+	if _, err := r.NetworkProvider(ctx, network); !resolver.IsVersionMismatch(err) {
+		t.Errorf("NetworkProvider() of version v1 = %v, want a VersionMismatchError", err)
+	}
+
+	network.Spec.ProviderRef.APIVersion = ""
+	network.Spec.ProviderRef.Name = "missing"
+	if _, err := r.NetworkProvider(ctx, network); !resolver.IsNotFound(err) {
+		t.Errorf("NetworkProvider() of a missing object = %v, want a NotFoundError", err)
+	}
+
+	network.Spec.ProviderRef.Kind = "VMXNET3NetworkInterface"
+	if _, err := r.NetworkProvider(ctx, network); !resolver.IsUnknownKind(err) {
+		t.Errorf("NetworkProvider() of a VMXNET3NetworkInterface = %v, want an UnknownKindError", err)
+	}
+
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns"},
+		Spec: v1alpha1.NetworkInterfaceSpec{
+			ProviderRef: &v1alpha1.NetworkInterfaceProviderReference{
+				APIGroup: v1alpha1.GroupName,
+				Kind:     "VMXNET3NetworkInterface",
+				Name:     "vmxnet3",
+			},
+		},
+	}
+	obj, err = r.NetworkInterfaceProvider(ctx, ni)
+	if err != nil {
+		t.Fatalf("NetworkInterfaceProvider() = %v", err)
+	}
+	if vmxnet3, ok := obj.(*v1alpha1.VMXNET3NetworkInterface); !ok || vmxnet3.Namespace != "ns" {
+		t.Errorf("NetworkInterfaceProvider() = %#v, want the VMXNET3NetworkInterface ns/vmxnet3", obj)
+	}
+
+	ni.Spec.Type = v1alpha1.NetworkInterfaceTypeE1000E
+	ni.Spec.ProviderRef.Kind = "E1000ENetworkInterface"
+	ni.Spec.ProviderRef.Name = "e1000e"
+	obj, err = r.NetworkInterfaceProvider(ctx, ni)
+	if err != nil {
+		t.Fatalf("NetworkInterfaceProvider() of an E1000ENetworkInterface = %v", err)
+	}
+	if e1000e, ok := obj.(*v1alpha1.E1000ENetworkInterface); !ok || e1000e.Name != "e1000e" {
+		t.Errorf("NetworkInterfaceProvider() = %#v, want the E1000ENetworkInterface ns/e1000e", obj)
+	}
+
+	ni.Spec.Type = v1alpha1.NetworkInterfaceTypeSRIOV
+	ni.Spec.ProviderRef.Kind = "SRIOVNetworkInterface"
+	ni.Spec.ProviderRef.Name = "sriov"
+	obj, err = r.NetworkInterfaceProvider(ctx, ni)
+	if err != nil {
+		t.Fatalf("NetworkInterfaceProvider() of an SRIOVNetworkInterface = %v", err)
+	}
+	if sriov, ok := obj.(*v1alpha1.SRIOVNetworkInterface); !ok || sriov.Spec.PhysicalFunction != "0000:3b:00.0" {
+		t.Errorf("NetworkInterfaceProvider() = %#v, want the SRIOVNetworkInterface ns/sriov", obj)
+	}
+
+	// The provider is looked up by the kind of the reference, so an object of
+	// another kind is not returned.
+	ni.Spec.ProviderRef.Name = "e1000e"
+	if _, err := r.NetworkInterfaceProvider(ctx, ni); !resolver.IsNotFound(err) {
+		t.Errorf("NetworkInterfaceProvider() of an SRIOVNetworkInterface named after an E1000ENetworkInterface = %v, "+
+			"want a NotFoundError", err)
+	}
+
+	// Providers of network interfaces are in the namespace of the interface.
+	ni.Spec.ProviderRef.Name = "sriov"
+	ni.Namespace = "other"
+	if _, err := r.NetworkInterfaceProvider(ctx, ni); !resolver.IsNotFound(err) {
+		t.Errorf("NetworkInterfaceProvider() in another namespace = %v, want a NotFoundError", err)
+	}
+
+	ni.Spec.ProviderRef = nil
+	if _, err := r.NetworkInterfaceProvider(ctx, ni); err != resolver.ErrNoProviderRef {
+		t.Errorf("NetworkInterfaceProvider() without a reference = %v, want ErrNoProviderRef", err)
+	}
+
+	lb := &v1alpha1.LoadBalancerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb", Namespace: "ns"},
+		Spec: v1alpha1.LoadBalancerConfigSpec{
+			ProviderRef: v1alpha1.LoadBalancerConfigProviderReference{
+				APIGroup: v1alpha1.GroupName,
+				Kind:     "HAProxyLoadBalancerConfig",
+				Name:     "haproxy",
+			},
+		},
+	}
+	obj, err = r.LoadBalancerProvider(ctx, lb)
+	if err != nil {
+		t.Fatalf("LoadBalancerProvider() = %v", err)
+	}
+	if haproxy, ok := obj.(*v1alpha1.HAProxyLoadBalancerConfig); !ok || haproxy.Name != "haproxy" {
+		t.Errorf("LoadBalancerProvider() = %#v, want the HAProxyLoadBalancerConfig haproxy", obj)
+	}
+
+	lb.Spec.ProviderRef.APIGroup = "example.com"
+	if _, err := r.LoadBalancerProvider(ctx, lb); !resolver.IsUnknownKind(err) {
+		t.Errorf("LoadBalancerProvider() of another group = %v, want an UnknownKindError", err)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simulator provides a controller-runtime manager that simulates Net
+// Operator, for use in integration tests against envtest or any other API
+// server that does not run the real Net Operator.
+//
+// The simulator reconciles the v1alpha1 kinds as follows:
+//
+//   - IPPool: the allocation counts and the ready, failure and full
+//     conditions are kept up to date, and held addresses are freed when they
+//     expire.
+//   - VSphereDistributedNetwork: the port group is assumed to exist, and the
+//     IPPoolInvalid and IPPoolPressure conditions reflect the referenced pools
+//     of each family.
+//   - NetworkInterface: an IP address is allocated from the pools of the
+//     network's VSphereDistributedNetwork and recorded in the pool's status, a
+//     MAC address and a port are assigned, and the Ready condition is set. On
+//     a dual-stack network, an address of each family assigned from a static
+//     pool is allocated, and the interface gets one IPConfig per family. The
+//     routes of the network are merged into the IPConfig of their family. The
+//     requested addresses of the interface are allocated instead of free ones,
+//     or the RequestedIPUnavailable failure is set if they are outside the
+//     pools or allocated to another interface. The allocations are released
+//     when the interface is deleted, the sticky ones are held for the identity
+//     of the interface and allocated to the next interface with that identity.
+//
+// NetworkInterfaces with the NetworkInterfaceClientManagedAnnotation are not
+// reconciled, except that the addresses of deleted ones are released. No
+// addresses are allocated for a NetworkInterface when it or its Network has
+// the IPAMDisabledAnnotationKeyName annotation.
+package simulator
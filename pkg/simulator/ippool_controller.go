@@ -0,0 +1,79 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipam"
+)
+
+// IPPoolFailReasonInvalid is the reason set on a True IPPoolFail condition
+// when the spec of an IPPool does not describe a valid range.
+const IPPoolFailReasonInvalid = "Invalid"
+
+// ipPoolReconciler keeps the status of IPPools up to date.
+type ipPoolReconciler struct {
+	client client.Client
+}
+
+func addIPPoolController(mgr manager.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.IPPool{}).
+		Complete(&ipPoolReconciler{client: mgr.GetClient()})
+}
+
+func (r *ipPoolReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	pool := &v1alpha1.IPPool{}
+	if err := r.client.Get(ctx, req.NamespacedName, pool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	orig := pool.Status.DeepCopy()
+
+	setter, err := conditions.For(pool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if _, err := ipam.NewAllocator(pool); err != nil {
+		conditions.MarkFalse(setter, string(v1alpha1.IPPoolReady), IPPoolFailReasonInvalid, "%v", err)
+		conditions.Set(setter, conditions.Condition{
+			Type:    string(v1alpha1.IPPoolFail),
+			Status:  corev1.ConditionTrue,
+			Reason:  IPPoolFailReasonInvalid,
+			Message: err.Error(),
+		})
+	} else {
+		conditions.MarkTrue(setter, string(v1alpha1.IPPoolReady))
+		conditions.Delete(setter, string(v1alpha1.IPPoolFail))
+	}
+	pool.ExpireHeld(metav1.Now())
+	pool.UpdateAllocationStatus()
+
+	// Reconcile the pool again when the next held address expires.
+	var result ctrl.Result
+	if next, ok := pool.NextHeldExpiration(); ok {
+		result.RequeueAfter = time.Until(next.Time) + time.Second
+	}
+
+	if equality.Semantic.DeepEqual(orig, &pool.Status) {
+		return result, nil
+	}
+	if err := r.client.Status().Update(ctx, pool); err != nil && !apierrors.IsConflict(err) {
+		return ctrl.Result{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// requeueAfter is the delay after which an object whose reconciliation failed
+// because of the state of another object, ex. a full IPPool, is reconciled
+// again.
+const requeueAfter = 10 * time.Second
+
+// Options are the options of a simulator manager.
+type Options struct {
+	// Namespace restricts the namespaced objects reconciled by the manager to
+	// the given namespace. All namespaces are reconciled if empty.
+	Namespace string
+
+	// MetricsBindAddress is the address the metrics endpoint binds to. The
+	// metrics endpoint is disabled if empty.
+	MetricsBindAddress string
+}
+
+// NewScheme returns a scheme with the API versions used by the simulator.
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// NewManager returns a manager running the simulator's controllers against
+// the API server of the given config. Start the manager to start simulating.
+func NewManager(cfg *rest.Config, opts Options) (manager.Manager, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+
+	metricsBindAddress := opts.MetricsBindAddress
+	if metricsBindAddress == "" {
+		metricsBindAddress = "0"
+	}
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:             scheme,
+		Namespace:          opts.Namespace,
+		MetricsBindAddress: metricsBindAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := AddToManager(mgr); err != nil {
+		return nil, err
+	}
+	return mgr, nil
+}
+
+// AddToManager adds the simulator's controllers to the given manager. The
+// manager's scheme must include v1alpha1.
+func AddToManager(mgr manager.Manager) error {
+	if err := addIPPoolController(mgr); err != nil {
+		return err
+	}
+	if err := addVSphereDistributedNetworkController(mgr); err != nil {
+		return err
+	}
+	return addNetworkInterfaceController(mgr)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/internal/testenv"
+	"github.com/vmware-tanzu/net-operator-api/pkg/simulator"
+)
+
+func TestManagerEnvtest(t *testing.T) {
+	env := testenv.Start(t, testenv.Options{})
+	defer env.Stop(t)
+
+	mgr, err := simulator.NewManager(env.Config, simulator.Options{Namespace: "default"})
+	if err != nil {
+		t.Fatalf("NewManager() = %v", err)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		if err := mgr.Start(stop); err != nil {
+			t.Errorf("Start() = %v", err)
+		}
+	}()
+
+	ctx := context.Background()
+	c := env.Client
+	for _, obj := range []runtime.Object{
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+			Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+		},
+		&v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				IPPools:          []v1alpha1.IPPoolReference{{Name: "pool"}},
+				Gateway:          "192.168.1.1",
+				SubnetMask:       "255.255.255.0",
+			},
+		},
+		&v1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: "default"},
+			Spec: v1alpha1.NetworkSpec{
+				Type: v1alpha1.NetworkTypeVDS,
+				ProviderRef: v1alpha1.NetworkProviderReference{
+					APIGroup: v1alpha1.GroupName,
+					Kind:     "VSphereDistributedNetwork",
+					Name:     "vdn",
+				},
+			},
+		},
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "default"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+		},
+	} {
+		if err := c.Create(ctx, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The simulator realizes the NetworkInterface like a network provider.
+	ni := &v1alpha1.NetworkInterface{}
+	err = wait.PollImmediate(100*time.Millisecond, 30*time.Second, func() (bool, error) {
+		if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "ni"}, ni); err != nil {
+			return false, err
+		}
+		return ni.Status.MacAddress != "" && len(ni.Status.IPConfigs) > 0, nil
+	})
+	if err != nil {
+		t.Fatalf("NetworkInterface was not realized: %v, status %+v", err, ni.Status)
+	}
+	if ip := ni.Status.IPConfigs[0]; ip.IP != "192.168.1.10" || ip.Gateway != "192.168.1.1" || ip.SubnetMask != "255.255.255.0" {
+		t.Errorf("IPConfigs = %+v, want 192.168.1.10 with the gateway and subnet mask of the network", ni.Status.IPConfigs)
+	}
+	if ni.Status.NetworkID != "dvportgroup-1" || ni.Status.PortID == "" {
+		t.Errorf("NetworkID = %q, PortID = %q, want dvportgroup-1 and a port ID", ni.Status.NetworkID, ni.Status.PortID)
+	}
+}
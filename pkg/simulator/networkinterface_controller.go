@@ -0,0 +1,468 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipam"
+	"github.com/vmware-tanzu/net-operator-api/pkg/resolver"
+)
+
+// networkInterfaceReconciler realizes NetworkInterfaces on simulated
+// networks.
+type networkInterfaceReconciler struct {
+	client   client.Client
+	resolver *resolver.Resolver
+}
+
+func addNetworkInterfaceController(mgr manager.Manager) error {
+	r := &networkInterfaceReconciler{
+		client:   mgr.GetClient(),
+		resolver: resolver.New(mgr.GetClient()),
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.NetworkInterface{}).
+		Watches(&source.Kind{Type: &v1alpha1.Network{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.interfacesForNetwork),
+		}).
+		Complete(r)
+}
+
+// interfacesForNetwork returns a request for every NetworkInterface attached
+// to the given Network.
+func (r *networkInterfaceReconciler) interfacesForNetwork(obj handler.MapObject) []reconcile.Request {
+	list := &v1alpha1.NetworkInterfaceList{}
+	if err := r.client.List(context.Background(), list, client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, ni := range list.Items {
+		if ni.Spec.NetworkName == obj.Meta.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: ni.Namespace, Name: ni.Name},
+			})
+		}
+	}
+	return requests
+}
+
+func (r *networkInterfaceReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	ni := &v1alpha1.NetworkInterface{}
+	if err := r.client.Get(ctx, req.NamespacedName, ni); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	// The finalizer of a NetworkInterface that became client managed after it
+	// was added must still be removed.
+	if !ni.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.reconcileDelete(ctx, ni)
+	}
+	if metav1.HasAnnotation(ni.ObjectMeta, v1alpha1.NetworkInterfaceClientManagedAnnotation) {
+		return ctrl.Result{}, nil
+	}
+	if !containsString(ni.Finalizers, v1alpha1.NetworkInterfaceFinalizer) {
+		ni.Finalizers = append(ni.Finalizers, v1alpha1.NetworkInterfaceFinalizer)
+		if err := r.client.Update(ctx, ni); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return r.reconcileNormal(ctx, ni)
+}
+
+// reconcileDelete releases the addresses allocated to the NetworkInterface and
+// removes its finalizer. The sticky addresses are held for the identity of
+// the NetworkInterface for the grace period of their pool.
+func (r *networkInterfaceReconciler) reconcileDelete(ctx context.Context, ni *v1alpha1.NetworkInterface) error {
+	if !containsString(ni.Finalizers, v1alpha1.NetworkInterfaceFinalizer) {
+		return nil
+	}
+
+	pools := &v1alpha1.IPPoolList{}
+	if err := r.client.List(ctx, pools); err != nil {
+		return err
+	}
+	ref := interfaceRef(ni)
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+		expiration := metav1.NewTime(time.Now().Add(pool.StickyAllocationGracePeriod()))
+		if pool.ReleaseAllocationsFor(ref, expiration) == 0 {
+			continue
+		}
+		if err := r.client.Status().Update(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	ni.Finalizers = removeString(ni.Finalizers, v1alpha1.NetworkInterfaceFinalizer)
+	return r.client.Update(ctx, ni)
+}
+
+// reconcileNormal realizes the NetworkInterface and updates its status.
+func (r *networkInterfaceReconciler) reconcileNormal(ctx context.Context, ni *v1alpha1.NetworkInterface) (ctrl.Result, error) {
+	orig := ni.Status.DeepCopy()
+	setter, err := conditions.For(ni)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var result ctrl.Result
+	reason, err := r.realize(ctx, ni)
+	switch {
+	case err != nil && reason == "":
+		return ctrl.Result{}, err
+	case err != nil:
+		conditions.MarkFalse(setter, string(v1alpha1.NetworkInterfaceReady), string(reason), "%v", err)
+		conditions.Set(setter, conditions.Condition{
+			Type:    string(v1alpha1.NetworkInterfaceFailure),
+			Status:  corev1.ConditionTrue,
+			Reason:  string(reason),
+			Message: err.Error(),
+		})
+		result.RequeueAfter = requeueAfter
+	default:
+		conditions.MarkTrue(setter, string(v1alpha1.NetworkInterfaceReady))
+		conditions.Delete(setter, string(v1alpha1.NetworkInterfaceFailure))
+	}
+
+	if equality.Semantic.DeepEqual(orig, &ni.Status) {
+		return result, nil
+	}
+	if err := r.client.Status().Update(ctx, ni); err != nil && !apierrors.IsConflict(err) {
+		return ctrl.Result{}, err
+	}
+	return result, nil
+}
+
+// realize fills the status of the NetworkInterface from its network. The
+// returned reason is empty if realizing failed because of an API error that
+// should be retried.
+func (r *networkInterfaceReconciler) realize(
+	ctx context.Context,
+	ni *v1alpha1.NetworkInterface) (v1alpha1.NetworkInterfaceConditionReason, error) {
+
+	network := &v1alpha1.Network{}
+	key := client.ObjectKey{Namespace: ni.Namespace, Name: ni.Spec.NetworkName}
+	if err := r.client.Get(ctx, key, network); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v1alpha1.NetworkInterfaceFailureReasonCannotAllocPort,
+				fmt.Errorf("network %q not found", ni.Spec.NetworkName)
+		}
+		return "", err
+	}
+
+	provider, err := r.resolver.NetworkProvider(ctx, network)
+	if err != nil {
+		if resolver.IsNotFound(err) || resolver.IsUnknownKind(err) || resolver.IsVersionMismatch(err) {
+			return v1alpha1.NetworkInterfaceFailureReasonCannotAllocPort, err
+		}
+		return "", err
+	}
+
+	ipamDisabled := metav1.HasAnnotation(ni.ObjectMeta, v1alpha1.IPAMDisabledAnnotationKeyName) ||
+		metav1.HasAnnotation(network.ObjectMeta, v1alpha1.IPAMDisabledAnnotationKeyName)
+
+	switch p := provider.(type) {
+	case *v1alpha1.VSphereDistributedNetwork:
+		ni.Status.NetworkID = p.Spec.PortGroupID
+		if !ipamDisabled {
+			if reason, err := r.allocateIPs(ctx, ni, p); err != nil {
+				return reason, err
+			}
+		}
+	case *v1alpha1.NSXTNetwork:
+		ni.Status.NetworkID = p.Spec.SegmentPath
+	default:
+		return v1alpha1.NetworkInterfaceFailureReasonCannotAllocPort,
+			fmt.Errorf("unsupported network provider %T", provider)
+	}
+
+	if ni.Status.MacAddress == "" {
+		ni.Status.MacAddress = macAddress(ni.UID)
+	}
+	if ni.Status.PortID == "" {
+		ni.Status.PortID = portID(ni.UID)
+	}
+	return "", nil
+}
+
+// allocateIPs ensures that an address of every family of the network that is
+// assigned from a static pool is allocated to the NetworkInterface, and sets
+// its IPConfigs, one per family. The IPConfigs are left unchanged if no family
+// is assigned from a static pool.
+func (r *networkInterfaceReconciler) allocateIPs(
+	ctx context.Context,
+	ni *v1alpha1.NetworkInterface,
+	network *v1alpha1.VSphereDistributedNetwork) (v1alpha1.NetworkInterfaceConditionReason, error) {
+
+	sets := staticPoolSets(network)
+	if len(sets) == 0 {
+		return "", nil
+	}
+
+	// Every requested address must be of a family assigned from a static pool.
+	for _, c := range ni.Spec.RequestedIPConfigs {
+		if family := requestedIPFamily(c); !setsInclude(sets, family) {
+			return v1alpha1.NetworkInterfaceFailureReasonRequestedIPUnavailable,
+				fmt.Errorf("requested IP %s is unavailable on network %q: %s addresses are not assigned from IPPools",
+					c.IP, network.Name, family)
+		}
+	}
+
+	var ipConfigs []v1alpha1.IPConfig
+	for _, set := range sets {
+		var pools []*v1alpha1.IPPool
+		for _, ref := range set.refs {
+			pool := &v1alpha1.IPPool{}
+			if err := r.client.Get(ctx, client.ObjectKey{Name: ref.Name}, pool); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return "", err
+			}
+			pools = append(pools, pool)
+		}
+
+		var requested []v1alpha1.RequestedIPConfig
+		for _, c := range ni.Spec.RequestedIPConfigs {
+			if set.family == "" || requestedIPFamily(c) == set.family {
+				requested = append(requested, c)
+			}
+		}
+		if len(requested) > 0 {
+			configs, reason, err := r.allocateRequestedIPs(ctx, ni, network, pools, requested)
+			if err != nil {
+				return reason, err
+			}
+			ipConfigs = append(ipConfigs, configs...)
+			continue
+		}
+
+		c, reason, err := r.allocateIP(ctx, ni, network, pools, set.family)
+		if err != nil {
+			if set.family != "" {
+				err = fmt.Errorf("%s: %w", set.family, err)
+			}
+			return reason, err
+		}
+		ipConfigs = append(ipConfigs, c)
+	}
+	ni.Status.IPConfigs = ipConfigs
+	return "", nil
+}
+
+// requestedIPFamily returns the family of the requested address.
+func requestedIPFamily(c v1alpha1.RequestedIPConfig) corev1.IPFamily {
+	if c.IPFamily != "" {
+		return c.IPFamily
+	}
+	if net.ParseIP(c.IP).To4() != nil {
+		return corev1.IPv4Protocol
+	}
+	return corev1.IPv6Protocol
+}
+
+// setsInclude returns true if one of the sets is for addresses of the given
+// family.
+func setsInclude(sets []poolSet, family corev1.IPFamily) bool {
+	for _, set := range sets {
+		if set.family == "" || set.family == family {
+			return true
+		}
+	}
+	return false
+}
+
+// allocateIP ensures that an address from the given pools of the network is
+// allocated to the NetworkInterface, and returns its IPConfig. If family is
+// not empty, the pools whose addresses are of another family are skipped.
+func (r *networkInterfaceReconciler) allocateIP(
+	ctx context.Context,
+	ni *v1alpha1.NetworkInterface,
+	network *v1alpha1.VSphereDistributedNetwork,
+	pools []*v1alpha1.IPPool,
+	family corev1.IPFamily) (v1alpha1.IPConfig, v1alpha1.NetworkInterfaceConditionReason, error) {
+
+	// Reuse an address allocated by an earlier reconciliation, ex. one whose
+	// update of the NetworkInterface's status failed.
+	ref := interfaceRef(ni)
+	for _, pool := range pools {
+		if allocations := pool.GetAllocationsFor(ref); len(allocations) > 0 {
+			return ipConfig(allocations[0].IP, ni, network), "", nil
+		}
+	}
+
+	allocators := make([]*ipam.Allocator, len(pools))
+	for i, pool := range pools {
+		if allocator, err := ipam.NewAllocatorFromStatus(pool); err == nil && (family == "" || allocator.Family() == family) {
+			allocators[i] = allocator
+		}
+	}
+	// An address held for the identity of the NetworkInterface in any of the
+	// pools is preferred over a free address.
+	for _, heldOnly := range []bool{true, false} {
+		for i, pool := range pools {
+			allocator := allocators[i]
+			if allocator == nil {
+				continue
+			}
+			identity := ipam.Identity(ni, pool.Spec.StickyAllocation)
+			if heldOnly && (identity == "" || allocator.HeldFor(identity) == nil) {
+				continue
+			}
+			ip, err := allocator.AllocateFor(identity)
+			if err != nil {
+				continue
+			}
+			pool.SetStickyAllocation(ip.String(), ref, identity)
+			if err := r.client.Status().Update(ctx, pool); err != nil {
+				return v1alpha1.IPConfig{}, "", err
+			}
+			return ipConfig(ip.String(), ni, network), "", nil
+		}
+	}
+	return v1alpha1.IPConfig{}, v1alpha1.NetworkInterfaceFailureReasonCannotAllocIP,
+		fmt.Errorf("no free addresses in the IPPools of network %q", network.Name)
+}
+
+// allocateRequestedIPs ensures that the given requested addresses, and no
+// other addresses of the given pools, are allocated to the NetworkInterface,
+// and returns their IPConfigs.
+func (r *networkInterfaceReconciler) allocateRequestedIPs(
+	ctx context.Context,
+	ni *v1alpha1.NetworkInterface,
+	network *v1alpha1.VSphereDistributedNetwork,
+	pools []*v1alpha1.IPPool,
+	configs []v1alpha1.RequestedIPConfig) ([]v1alpha1.IPConfig, v1alpha1.NetworkInterfaceConditionReason, error) {
+
+	ref := interfaceRef(ni)
+	requested := map[string]bool{}
+	var ipConfigs []v1alpha1.IPConfig
+	for _, c := range configs {
+		pool, err := ipam.PoolForRequestedIP(pools, c.IP, ni)
+		if err != nil {
+			return nil, v1alpha1.NetworkInterfaceFailureReasonRequestedIPUnavailable,
+				fmt.Errorf("requested IP %s is unavailable on network %q: %v", c.IP, network.Name, err)
+		}
+		if pool.GetAllocation(c.IP) == nil {
+			pool.SetStickyAllocation(c.IP, ref, ipam.Identity(ni, pool.Spec.StickyAllocation))
+			if err := r.client.Status().Update(ctx, pool); err != nil {
+				return nil, "", err
+			}
+		}
+		requested[net.ParseIP(c.IP).String()] = true
+		ipConfigs = append(ipConfigs, ipConfig(c.IP, ni, network))
+	}
+
+	// Release the addresses allocated before the request, ex. free addresses
+	// allocated before the request was added.
+	for _, pool := range pools {
+		released := false
+		for _, allocation := range pool.GetAllocationsFor(ref) {
+			if ip := net.ParseIP(allocation.IP); ip == nil || !requested[ip.String()] {
+				released = pool.RemoveAllocation(allocation.IP) || released
+			}
+		}
+		if released {
+			if err := r.client.Status().Update(ctx, pool); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+	return ipConfigs, "", nil
+}
+
+func interfaceRef(ni *v1alpha1.NetworkInterface) v1alpha1.NetworkInterfaceReference {
+	return v1alpha1.NetworkInterfaceReference{
+		Name:      ni.Name,
+		Namespace: ni.Namespace,
+		UID:       ni.UID,
+	}
+}
+
+// ipConfig returns the IPConfig of the given address of the NetworkInterface on
+// the network, with the routes of the network of the address's family. The
+// gateway and prefix of IPv6 addresses on a dual-stack network are those of its
+// IPv6 configuration. The IPConfig has no gateway if the NetworkInterface does
+// not take the default route.
+func ipConfig(ip string, ni *v1alpha1.NetworkInterface, network *v1alpha1.VSphereDistributedNetwork) v1alpha1.IPConfig {
+	c := v1alpha1.IPConfig{
+		IP:         ip,
+		IPFamily:   corev1.IPv6Protocol,
+		Gateway:    network.Spec.Gateway,
+		SubnetMask: network.Spec.SubnetMask,
+	}
+	if net.ParseIP(ip).To4() != nil {
+		c.IPFamily = corev1.IPv4Protocol
+	}
+	if v6 := network.Spec.IPv6; v6 != nil && c.IPFamily == corev1.IPv6Protocol {
+		prefixLength := v6.PrefixLength
+		if prefixLength == 0 {
+			prefixLength = v1alpha1.DefaultIPv6PrefixLength
+		}
+		c.Gateway = v6.Gateway
+		c.SubnetMask = net.IP(net.CIDRMask(int(prefixLength), 8*net.IPv6len)).String()
+	}
+	if ni.Spec.DefaultRoute != nil && !*ni.Spec.DefaultRoute {
+		c.Gateway = ""
+	}
+	c.MergeRoutes(network.Spec.Routes)
+	return c
+}
+
+// macAddress returns a MAC address derived from uid in the range VMware
+// reserves for manually assigned addresses, 00:50:56:00:00:00 to
+// 00:50:56:3f:ff:ff.
+func macAddress(uid types.UID) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(uid))
+	v := h.Sum32() & 0x3fffff
+	return fmt.Sprintf("00:50:56:%02x:%02x:%02x", v>>16, (v>>8)&0xff, v&0xff)
+}
+
+// portID returns a distributed port key derived from uid.
+func portID(uid types.UID) string {
+	h := fnv.New32()
+	_, _ = h.Write([]byte(uid))
+	return strconv.FormatUint(uint64(h.Sum32()), 10)
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(s []string, v string) []string {
+	var out []string
+	for _, e := range s {
+		if e != v {
+			out = append(out, e)
+		}
+	}
+	return out
+}
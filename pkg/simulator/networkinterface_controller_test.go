@@ -0,0 +1,561 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+	"github.com/vmware-tanzu/net-operator-api/pkg/resolver"
+)
+
+// newNetworkInterfaceReconciler returns a reconciler of the given objects.
+func newNetworkInterfaceReconciler(t *testing.T, objs ...runtime.Object) *networkInterfaceReconciler {
+	scheme, err := NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewFakeClientWithScheme(scheme, objs...)
+	return &networkInterfaceReconciler{client: c, resolver: resolver.New(c)}
+}
+
+// staticNetwork returns a Network on a VSphereDistributedNetwork assigning
+// addresses from a pool of 10 addresses.
+func staticNetwork() []runtime.Object {
+	return []runtime.Object{
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+			Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+		},
+		&v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				IPPools:          []v1alpha1.IPPoolReference{{Name: "pool"}},
+				Gateway:          "192.168.1.1",
+				SubnetMask:       "255.255.255.0",
+			},
+		},
+		&v1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: "ns"},
+			Spec: v1alpha1.NetworkSpec{
+				Type: v1alpha1.NetworkTypeVDS,
+				ProviderRef: v1alpha1.NetworkProviderReference{
+					APIGroup: v1alpha1.GroupName,
+					Kind:     "VSphereDistributedNetwork",
+					Name:     "vdn",
+				},
+			},
+		},
+	}
+}
+
+func reconcileInterface(t *testing.T, r *networkInterfaceReconciler, name string) ctrl.Result {
+	result, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: name}})
+	if err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	return result
+}
+
+func TestNetworkInterfaceAllocate(t *testing.T) {
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns", UID: "ni-uid"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}
+	r := newNetworkInterfaceReconciler(t, append(staticNetwork(), ni)...)
+	reconcileInterface(t, r, "ni")
+
+	ctx := context.Background()
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "ni"}, ni); err != nil {
+		t.Fatal(err)
+	}
+	if !containsString(ni.Finalizers, v1alpha1.NetworkInterfaceFinalizer) {
+		t.Errorf("finalizers = %v, want %s", ni.Finalizers, v1alpha1.NetworkInterfaceFinalizer)
+	}
+	want := v1alpha1.IPConfig{IP: "192.168.1.10", IPFamily: "IPv4", Gateway: "192.168.1.1", SubnetMask: "255.255.255.0"}
+	if len(ni.Status.IPConfigs) != 1 || !equalIPConfig(ni.Status.IPConfigs[0], want) {
+		t.Errorf("IPConfigs = %+v, want [%+v]", ni.Status.IPConfigs, want)
+	}
+	if ni.Status.NetworkID != "dvportgroup-1" || ni.Status.MacAddress == "" || ni.Status.PortID == "" {
+		t.Errorf("status = %+v, want a network ID, MAC address and port ID", ni.Status)
+	}
+
+	pool := &v1alpha1.IPPool{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, pool); err != nil {
+		t.Fatal(err)
+	}
+	if a := pool.GetAllocation("192.168.1.10"); a == nil || !a.NetworkInterfaceRef.Matches(interfaceRef(ni)) {
+		t.Errorf("allocation of 192.168.1.10 = %+v, want one owned by ns/ni", a)
+	}
+}
+
+func TestNetworkInterfaceDeleteClientManaged(t *testing.T) {
+	now := metav1.Now()
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ni",
+			Namespace:         "ns",
+			UID:               "ni-uid",
+			Finalizers:        []string{v1alpha1.NetworkInterfaceFinalizer},
+			DeletionTimestamp: &now,
+			// The interface became client managed after its address was
+			// allocated.
+			Annotations: map[string]string{v1alpha1.NetworkInterfaceClientManagedAnnotation: ""},
+		},
+		Spec: v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}
+	objs := staticNetwork()
+	pool := objs[0].(*v1alpha1.IPPool)
+	pool.SetAllocation("192.168.1.10", interfaceRef(ni))
+
+	r := newNetworkInterfaceReconciler(t, append(objs, ni)...)
+	reconcileInterface(t, r, "ni")
+
+	ctx := context.Background()
+	deleted := &v1alpha1.NetworkInterface{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "ni"}, deleted); err != nil {
+		t.Fatal(err)
+	}
+	if containsString(deleted.Finalizers, v1alpha1.NetworkInterfaceFinalizer) {
+		t.Errorf("finalizer of the deleted client managed NetworkInterface was not removed")
+	}
+	released := &v1alpha1.IPPool{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, released); err != nil {
+		t.Fatal(err)
+	}
+	if a := released.GetAllocation("192.168.1.10"); a != nil {
+		t.Errorf("allocation %+v of the deleted NetworkInterface was not released", a)
+	}
+}
+
+func TestNetworkInterfaceClientManaged(t *testing.T) {
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "ni",
+			Namespace:   "ns",
+			Annotations: map[string]string{v1alpha1.NetworkInterfaceClientManagedAnnotation: ""},
+		},
+		Spec: v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}
+	r := newNetworkInterfaceReconciler(t, append(staticNetwork(), ni)...)
+	reconcileInterface(t, r, "ni")
+
+	if err := r.client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "ni"}, ni); err != nil {
+		t.Fatal(err)
+	}
+	if len(ni.Finalizers) > 0 || len(ni.Status.IPConfigs) > 0 {
+		t.Errorf("client managed NetworkInterface was reconciled: %+v", ni)
+	}
+}
+
+func equalIPConfig(a, b v1alpha1.IPConfig) bool {
+	return a.IP == b.IP && a.IPFamily == b.IPFamily && a.Gateway == b.Gateway && a.SubnetMask == b.SubnetMask
+}
+
+// TestNetworkInterfaceStickyAllocation tests that the address of a deleted
+// NetworkInterface is held for its identity, reclaimed by the NetworkInterface
+// recreated with that identity, and freed when its hold expires.
+func TestNetworkInterfaceStickyAllocation(t *testing.T) {
+	controller := true
+	newInterface := func(name string, uid types.UID) *v1alpha1.NetworkInterface {
+		return &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				UID:       uid,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "VirtualMachine", Name: "vm", UID: "vm-uid", Controller: &controller},
+				},
+			},
+			Spec: v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+		}
+	}
+	objs := staticNetwork()
+	objs[0].(*v1alpha1.IPPool).Spec.StickyAllocation = &v1alpha1.StickyAllocation{}
+	r := newNetworkInterfaceReconciler(t, append(objs, newInterface("ni", "ni-uid"))...)
+	ctx := context.Background()
+
+	// Allocate the first address, and another one to a different interface so
+	// that the next free address is not the one of ni.
+	reconcileInterface(t, r, "ni")
+	if err := r.client.Create(ctx, &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns", UID: "other-uid"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	reconcileInterface(t, r, "other")
+
+	ni := &v1alpha1.NetworkInterface{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "ni"}, ni); err != nil {
+		t.Fatal(err)
+	}
+	now := metav1.Now()
+	ni.DeletionTimestamp = &now
+	if err := r.client.Update(ctx, ni); err != nil {
+		t.Fatal(err)
+	}
+	reconcileInterface(t, r, "ni")
+
+	pool := &v1alpha1.IPPool{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, pool); err != nil {
+		t.Fatal(err)
+	}
+	held := pool.GetHeld("ns/VirtualMachine/vm")
+	if held == nil || held.IP != "192.168.1.10" {
+		t.Fatalf("Held = %+v, want 192.168.1.10 held for ns/VirtualMachine/vm", pool.Status.Held)
+	}
+	if pool.GetAllocation("192.168.1.10") != nil {
+		t.Errorf("address of the deleted NetworkInterface is still allocated")
+	}
+
+	// The recreated NetworkInterface reclaims the held address.
+	if err := r.client.Create(ctx, newInterface("recreated", "recreated-uid")); err != nil {
+		t.Fatal(err)
+	}
+	reconcileInterface(t, r, "recreated")
+	recreated := &v1alpha1.NetworkInterface{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "recreated"}, recreated); err != nil {
+		t.Fatal(err)
+	}
+	if len(recreated.Status.IPConfigs) != 1 || recreated.Status.IPConfigs[0].IP != "192.168.1.10" {
+		t.Errorf("IPConfigs of the recreated NetworkInterface = %+v, want the held address 192.168.1.10",
+			recreated.Status.IPConfigs)
+	}
+	reclaimed := &v1alpha1.IPPool{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, reclaimed); err != nil {
+		t.Fatal(err)
+	}
+	if len(reclaimed.Status.Held) != 0 {
+		t.Errorf("Held = %+v after the address was reclaimed, want none", reclaimed.Status.Held)
+	}
+
+	// An expired hold is freed by the IPPool reconciler.
+	reclaimed.Status.Held = []v1alpha1.IPPoolHeldAddress{
+		{IP: "192.168.1.12", Identity: "ns/VirtualMachine/gone", ExpirationTime: now},
+	}
+	if err := r.client.Status().Update(ctx, reclaimed); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&ipPoolReconciler{client: r.client}).Reconcile(ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "pool"},
+	}); err != nil {
+		t.Fatalf("Reconcile() of the IPPool = %v", err)
+	}
+	expired := &v1alpha1.IPPool{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, expired); err != nil {
+		t.Fatal(err)
+	}
+	if len(expired.Status.Held) != 0 || expired.Status.HeldCount != 0 {
+		t.Errorf("status = %+v, want the expired hold freed", expired.Status)
+	}
+}
+
+// TestNetworkInterfaceDualStack tests that a NetworkInterface on a dual-stack
+// network gets one IPConfig per family, with the gateway and prefix of the
+// family, and that only the pools of a family are allocated from for it.
+func TestNetworkInterfaceDualStack(t *testing.T) {
+	objs := staticNetwork()
+	vdn := objs[1].(*v1alpha1.VSphereDistributedNetwork)
+	// The IPv6 pool is also referenced for IPv4 addresses by mistake.
+	vdn.Spec.IPPools = []v1alpha1.IPPoolReference{{Name: "pool6"}, {Name: "pool"}}
+	vdn.Spec.IPv6 = &v1alpha1.IPv6Config{
+		IPPools:      []v1alpha1.IPPoolReference{{Name: "pool6"}},
+		Gateway:      "fd00::1",
+		PrefixLength: 64,
+	}
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns", UID: "ni-uid"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}
+	pool6 := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool6"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "fd00::10", AddressCount: 10},
+	}
+	r := newNetworkInterfaceReconciler(t, append(objs, pool6, ni)...)
+	reconcileInterface(t, r, "ni")
+
+	if err := r.client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "ni"}, ni); err != nil {
+		t.Fatal(err)
+	}
+	want := []v1alpha1.IPConfig{
+		{IP: "192.168.1.10", IPFamily: "IPv4", Gateway: "192.168.1.1", SubnetMask: "255.255.255.0"},
+		{IP: "fd00::10", IPFamily: "IPv6", Gateway: "fd00::1", SubnetMask: "ffff:ffff:ffff:ffff::"},
+	}
+	if len(ni.Status.IPConfigs) != len(want) {
+		t.Fatalf("IPConfigs = %+v, want %+v", ni.Status.IPConfigs, want)
+	}
+	for i := range want {
+		if !equalIPConfig(ni.Status.IPConfigs[i], want[i]) {
+			t.Errorf("IPConfigs[%d] = %+v, want %+v", i, ni.Status.IPConfigs[i], want[i])
+		}
+	}
+}
+
+// TestNetworkInterfaceNetworkRoutes tests that the routes of a dual-stack
+// network are merged into the IPConfig of their family.
+func TestNetworkInterfaceNetworkRoutes(t *testing.T) {
+	objs := staticNetwork()
+	vdn := objs[1].(*v1alpha1.VSphereDistributedNetwork)
+	vdn.Spec.IPv6 = &v1alpha1.IPv6Config{IPPools: []v1alpha1.IPPoolReference{{Name: "pool6"}}}
+	vdn.Spec.Routes = []v1alpha1.Route{
+		{Destination: "10.20.0.0/16", NextHop: "192.168.1.2", Metric: 100},
+		{Destination: "fd00:20::/64", NextHop: "fd00::2"},
+	}
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns", UID: "ni-uid"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}
+	pool6 := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool6"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "fd00::10", AddressCount: 10},
+	}
+	r := newNetworkInterfaceReconciler(t, append(objs, pool6, ni)...)
+	reconcileInterface(t, r, "ni")
+
+	if err := r.client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "ni"}, ni); err != nil {
+		t.Fatal(err)
+	}
+	if len(ni.Status.IPConfigs) != 2 {
+		t.Fatalf("IPConfigs = %+v, want one IPv4 and one IPv6 IPConfig", ni.Status.IPConfigs)
+	}
+	for i, want := range vdn.Spec.Routes {
+		routes := ni.Status.IPConfigs[i].Routes
+		if len(routes) != 1 || routes[0] != want {
+			t.Errorf("IPConfigs[%d].Routes = %+v, want [%+v]", i, routes, want)
+		}
+	}
+}
+
+// TestNetworkInterfaceDefaultRoute tests that a VM with two network interfaces
+// takes the default route on one of them and only the routes of its network on
+// the other.
+func TestNetworkInterfaceDefaultRoute(t *testing.T) {
+	objs := staticNetwork()
+	storagePool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage-pool"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "10.0.0.10", AddressCount: 10},
+	}
+	storageVDN := &v1alpha1.VSphereDistributedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage-vdn"},
+		Spec: v1alpha1.VSphereDistributedNetworkSpec{
+			PortGroupID:      "dvportgroup-2",
+			IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+			IPPools:          []v1alpha1.IPPoolReference{{Name: "storage-pool"}},
+			Gateway:          "10.0.0.1",
+			SubnetMask:       "255.255.255.0",
+			Routes:           []v1alpha1.Route{{Destination: "10.10.0.0/16", NextHop: "10.0.0.1"}},
+		},
+	}
+	storage := &v1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage", Namespace: "ns"},
+		Spec: v1alpha1.NetworkSpec{
+			Type: v1alpha1.NetworkTypeVDS,
+			ProviderRef: v1alpha1.NetworkProviderReference{
+				APIGroup: v1alpha1.GroupName,
+				Kind:     "VSphereDistributedNetwork",
+				Name:     "storage-vdn",
+			},
+		},
+	}
+	defaultRoute := false
+	nics := []runtime.Object{
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm-eth0", Namespace: "ns", UID: "eth0-uid"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+		},
+		&v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm-eth1", Namespace: "ns", UID: "eth1-uid"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "storage", DefaultRoute: &defaultRoute},
+		},
+	}
+	objs = append(objs, storagePool, storageVDN, storage)
+	r := newNetworkInterfaceReconciler(t, append(objs, nics...)...)
+
+	tests := []struct {
+		name string
+		want v1alpha1.IPConfig
+	}{
+		{
+			name: "vm-eth0",
+			want: v1alpha1.IPConfig{IP: "192.168.1.10", IPFamily: "IPv4", Gateway: "192.168.1.1", SubnetMask: "255.255.255.0"},
+		},
+		{
+			name: "vm-eth1",
+			want: v1alpha1.IPConfig{
+				IP:         "10.0.0.10",
+				IPFamily:   "IPv4",
+				SubnetMask: "255.255.255.0",
+				Routes:     []v1alpha1.Route{{Destination: "10.10.0.0/16", NextHop: "10.0.0.1"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		reconcileInterface(t, r, test.name)
+		ni := &v1alpha1.NetworkInterface{}
+		if err := r.client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: test.name}, ni); err != nil {
+			t.Fatal(err)
+		}
+		configs := ni.Status.IPConfigs
+		if len(configs) != 1 || !equalIPConfig(configs[0], test.want) || !reflect.DeepEqual(configs[0].Routes, test.want.Routes) {
+			t.Errorf("%s: IPConfigs = %+v, want [%+v]", test.name, configs, test.want)
+		}
+	}
+}
+
+// TestNetworkInterfaceIPAMDisabled tests that no address is allocated to a
+// NetworkInterface when IPAM is disabled on it or on its network, while the
+// rest of its status is still realized.
+func TestNetworkInterfaceIPAMDisabled(t *testing.T) {
+	disabled := map[string]string{v1alpha1.IPAMDisabledAnnotationKeyName: ""}
+	for _, onNetwork := range []bool{false, true} {
+		ni := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns", UID: "ni-uid"},
+			Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+		}
+		objs := staticNetwork()
+		if onNetwork {
+			objs[2].(*v1alpha1.Network).Annotations = disabled
+		} else {
+			ni.Annotations = disabled
+		}
+		r := newNetworkInterfaceReconciler(t, append(objs, ni)...)
+		reconcileInterface(t, r, "ni")
+
+		ctx := context.Background()
+		if err := r.client.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "ni"}, ni); err != nil {
+			t.Fatal(err)
+		}
+		if len(ni.Status.IPConfigs) > 0 {
+			t.Errorf("IPAM disabled on network %v: IPConfigs = %+v, want none", onNetwork, ni.Status.IPConfigs)
+		}
+		if ni.Status.NetworkID != "dvportgroup-1" || ni.Status.MacAddress == "" || ni.Status.PortID == "" {
+			t.Errorf("IPAM disabled on network %v: status = %+v, want a network ID, MAC address and port ID",
+				onNetwork, ni.Status)
+		}
+		setter, err := conditions.For(ni)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !conditions.IsTrue(setter, string(v1alpha1.NetworkInterfaceReady)) {
+			t.Errorf("IPAM disabled on network %v: conditions = %+v, want Ready", onNetwork, ni.Status.Conditions)
+		}
+
+		pool := &v1alpha1.IPPool{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, pool); err != nil {
+			t.Fatal(err)
+		}
+		if len(pool.Status.Allocations) > 0 {
+			t.Errorf("IPAM disabled on network %v: allocations = %+v, want none", onNetwork, pool.Status.Allocations)
+		}
+	}
+}
+
+// TestNetworkInterfaceRequestedIP tests that a requested address is allocated
+// to a NetworkInterface, and that the address allocated to it before the
+// request was added is released.
+func TestNetworkInterfaceRequestedIP(t *testing.T) {
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns", UID: "ni-uid"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}
+	r := newNetworkInterfaceReconciler(t, append(staticNetwork(), ni)...)
+	reconcileInterface(t, r, "ni")
+
+	ctx := context.Background()
+	key := client.ObjectKey{Namespace: "ns", Name: "ni"}
+	if err := r.client.Get(ctx, key, ni); err != nil {
+		t.Fatal(err)
+	}
+	ni.Spec.RequestedIPConfigs = []v1alpha1.RequestedIPConfig{{IP: "192.168.1.15", IPFamily: corev1.IPv4Protocol}}
+	if err := r.client.Update(ctx, ni); err != nil {
+		t.Fatal(err)
+	}
+	reconcileInterface(t, r, "ni")
+
+	if err := r.client.Get(ctx, key, ni); err != nil {
+		t.Fatal(err)
+	}
+	want := v1alpha1.IPConfig{IP: "192.168.1.15", IPFamily: "IPv4", Gateway: "192.168.1.1", SubnetMask: "255.255.255.0"}
+	if len(ni.Status.IPConfigs) != 1 || !equalIPConfig(ni.Status.IPConfigs[0], want) {
+		t.Errorf("IPConfigs = %+v, want [%+v]", ni.Status.IPConfigs, want)
+	}
+	pool := &v1alpha1.IPPool{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, pool); err != nil {
+		t.Fatal(err)
+	}
+	if a := pool.GetAllocation("192.168.1.15"); a == nil || !a.NetworkInterfaceRef.Matches(interfaceRef(ni)) {
+		t.Errorf("allocation of 192.168.1.15 = %+v, want one owned by ns/ni", a)
+	}
+	if a := pool.GetAllocation("192.168.1.10"); a != nil {
+		t.Errorf("allocation %+v made before the request was not released", a)
+	}
+}
+
+// TestNetworkInterfaceRequestedIPUnavailable tests that a NetworkInterface
+// requesting an address outside the pools of its network, or allocated to
+// another NetworkInterface, fails with the reason RequestedIPUnavailable.
+func TestNetworkInterfaceRequestedIPUnavailable(t *testing.T) {
+	other := v1alpha1.NetworkInterfaceReference{Name: "other", Namespace: "ns", UID: "other-uid"}
+	for _, ip := range []string{"192.168.1.100", "fd00::10", "192.168.1.12"} {
+		ni := &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns", UID: "ni-uid"},
+			Spec: v1alpha1.NetworkInterfaceSpec{
+				NetworkName:        "network",
+				RequestedIPConfigs: []v1alpha1.RequestedIPConfig{{IP: ip}},
+			},
+		}
+		objs := staticNetwork()
+		objs[0].(*v1alpha1.IPPool).SetAllocation("192.168.1.12", other)
+		r := newNetworkInterfaceReconciler(t, append(objs, ni)...)
+		if result := reconcileInterface(t, r, "ni"); result.RequeueAfter == 0 {
+			t.Errorf("%s: Reconcile() = %+v, want a requeue", ip, result)
+		}
+
+		ctx := context.Background()
+		if err := r.client.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "ni"}, ni); err != nil {
+			t.Fatal(err)
+		}
+		setter, err := conditions.For(ni)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reason := string(v1alpha1.NetworkInterfaceFailureReasonRequestedIPUnavailable)
+		failure := conditions.Get(setter, string(v1alpha1.NetworkInterfaceFailure))
+		if failure == nil || failure.Status != corev1.ConditionTrue || failure.Reason != reason {
+			t.Errorf("%s: Failure = %+v, want True with reason %s", ip, failure, reason)
+		}
+		if ready := conditions.Get(setter, string(v1alpha1.NetworkInterfaceReady)); ready == nil ||
+			ready.Status != corev1.ConditionFalse || ready.Reason != reason {
+			t.Errorf("%s: Ready = %+v, want False with reason %s", ip, ready, reason)
+		}
+		if len(ni.Status.IPConfigs) > 0 {
+			t.Errorf("%s: IPConfigs = %+v, want none", ip, ni.Status.IPConfigs)
+		}
+
+		pool := &v1alpha1.IPPool{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: "pool"}, pool); err != nil {
+			t.Fatal(err)
+		}
+		if a := pool.GetAllocation("192.168.1.12"); a == nil || !a.NetworkInterfaceRef.Matches(other) {
+			t.Errorf("%s: allocation of 192.168.1.12 = %+v, want the one of ns/other", ip, a)
+		}
+		if n := len(pool.Status.Allocations); n != 1 {
+			t.Errorf("%s: pool has %d allocations, want 1", ip, n)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipam"
+)
+
+const (
+	// IPPoolInvalidReasonNotFound is the reason set on a True IPPoolInvalid
+	// condition when a referenced IPPool does not exist.
+	IPPoolInvalidReasonNotFound = "NotFound"
+	// IPPoolInvalidReasonInvalid is the reason set on a True IPPoolInvalid
+	// condition when a referenced IPPool does not describe a valid range, or
+	// its addresses are not of the family it is referenced for.
+	IPPoolInvalidReasonInvalid = "Invalid"
+	// IPPoolInvalidReasonNoPools is the reason set on a True IPPoolInvalid
+	// condition when a network with static pool assignment references no
+	// IPPools for one of its families.
+	IPPoolInvalidReasonNoPools = "NoPools"
+
+	// IPPoolPressureReasonLow is the reason set on a True IPPoolPressure
+	// condition.
+	IPPoolPressureReasonLow = "LowOnFreeIPs"
+	// IPPoolPressureReasonAvailable is the reason set on a False IPPoolPressure
+	// condition.
+	IPPoolPressureReasonAvailable = "Available"
+
+	// IPPoolPressureThreshold is the percentage of free addresses in the pools
+	// of a network below which the network is under IPPoolPressure.
+	IPPoolPressureThreshold = 10
+)
+
+// vsphereDistributedNetworkReconciler sets the conditions of
+// VSphereDistributedNetworks.
+type vsphereDistributedNetworkReconciler struct {
+	client client.Client
+}
+
+func addVSphereDistributedNetworkController(mgr manager.Manager) error {
+	r := &vsphereDistributedNetworkReconciler{client: mgr.GetClient()}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.VSphereDistributedNetwork{}).
+		Watches(&source.Kind{Type: &v1alpha1.IPPool{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.networksForPool),
+		}).
+		Complete(r)
+}
+
+// networksForPool returns a request for every VSphereDistributedNetwork that
+// references the given IPPool.
+func (r *vsphereDistributedNetworkReconciler) networksForPool(obj handler.MapObject) []reconcile.Request {
+	list := &v1alpha1.VSphereDistributedNetworkList{}
+	if err := r.client.List(context.Background(), list); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range list.Items {
+		n := &list.Items[i]
+		for _, ref := range ipPoolRefs(n) {
+			if ref.Name == obj.Meta.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: n.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+func (r *vsphereDistributedNetworkReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	network := &v1alpha1.VSphereDistributedNetwork{}
+	if err := r.client.Get(ctx, req.NamespacedName, network); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	orig := network.Status.DeepCopy()
+
+	setter, err := conditions.For(network)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// There is no vCenter to look the port group up in, so it always exists.
+	conditions.Delete(setter, string(v1alpha1.VSphereDistributedNetworkPortGroupFailure))
+
+	if sets := staticPoolSets(network); len(sets) == 0 {
+		conditions.Delete(setter, string(v1alpha1.VSphereDistributedNetworkIPPoolInvalid))
+		conditions.Delete(setter, string(v1alpha1.VsphereDistributedNetworkIPPoolPressure))
+	} else if err := r.setIPPoolConditions(ctx, sets, setter); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if equality.Semantic.DeepEqual(orig, &network.Status) {
+		return ctrl.Result{}, nil
+	}
+	if err := r.client.Status().Update(ctx, network); err != nil && !apierrors.IsConflict(err) {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// poolSet is the list of IPPools from which the addresses of one IP family of a
+// network are assigned.
+type poolSet struct {
+	// family is the family of the addresses, or empty if the network is not
+	// dual-stack and the addresses may be of either family.
+	family corev1.IPFamily
+	refs   []v1alpha1.IPPoolReference
+}
+
+// staticPoolSets returns the pools of every family of the network that is
+// assigned from a static pool, the IPv4 family first.
+func staticPoolSets(network *v1alpha1.VSphereDistributedNetwork) []poolSet {
+	var sets []poolSet
+	v6 := network.Spec.IPv6
+	if network.Spec.IPAssignmentMode != v1alpha1.IPAssignmentModeDHCP {
+		set := poolSet{refs: network.Spec.IPPools}
+		if v6 != nil {
+			set.family = corev1.IPv4Protocol
+		}
+		sets = append(sets, set)
+	}
+	if v6 != nil && (v6.IPAssignmentMode == "" || v6.IPAssignmentMode == v1alpha1.IPAssignmentModeStaticPool) {
+		sets = append(sets, poolSet{family: corev1.IPv6Protocol, refs: v6.IPPools})
+	}
+	return sets
+}
+
+// ipPoolRefs returns all the IPPools referenced by the network, whatever their
+// assignment mode.
+func ipPoolRefs(network *v1alpha1.VSphereDistributedNetwork) []v1alpha1.IPPoolReference {
+	refs := network.Spec.IPPools
+	if v6 := network.Spec.IPv6; v6 != nil {
+		refs = append(refs[:len(refs):len(refs)], v6.IPPools...)
+	}
+	return refs
+}
+
+// setIPPoolConditions sets the IPPoolInvalid and IPPoolPressure conditions
+// from the given sets of IPPools of a network. A pool of a set is invalid if
+// its addresses are not of the family of the set, and the network is under
+// pressure if any of the sets is.
+func (r *vsphereDistributedNetworkReconciler) setIPPoolConditions(
+	ctx context.Context,
+	sets []poolSet,
+	setter conditions.Setter) error {
+
+	var (
+		noPools  []string
+		notFound []string
+		invalid  []string
+		free     []string
+		low      bool
+	)
+	for _, set := range sets {
+		// The sizes of IPv6 pools add up to more than an int64.
+		size, setFree := new(big.Int), new(big.Int)
+		for _, ref := range set.refs {
+			pool := &v1alpha1.IPPool{}
+			if err := r.client.Get(ctx, client.ObjectKey{Name: ref.Name}, pool); err != nil {
+				if apierrors.IsNotFound(err) {
+					notFound = append(notFound, ref.Name)
+					continue
+				}
+				return err
+			}
+			allocator, err := ipam.NewAllocatorFromStatus(pool)
+			if err != nil || (set.family != "" && allocator.Family() != set.family) {
+				invalid = append(invalid, ref.Name)
+				continue
+			}
+			size.Add(size, big.NewInt(allocator.Size()))
+			setFree.Add(setFree, big.NewInt(allocator.Free()))
+		}
+
+		addresses := "addresses"
+		if set.family != "" {
+			addresses = string(set.family) + " addresses"
+		}
+		if len(set.refs) == 0 {
+			noPools = append(noPools, addresses)
+		}
+		free = append(free, fmt.Sprintf("%d of %d %s free", setFree, size, addresses))
+		threshold := new(big.Int).Mul(size, big.NewInt(IPPoolPressureThreshold))
+		if new(big.Int).Mul(setFree, big.NewInt(100)).Cmp(threshold) < 0 || size.Sign() == 0 {
+			low = true
+		}
+	}
+
+	invalidType := string(v1alpha1.VSphereDistributedNetworkIPPoolInvalid)
+	switch {
+	case len(noPools) > 0:
+		conditions.Set(setter, conditions.Condition{
+			Type:    invalidType,
+			Status:  corev1.ConditionTrue,
+			Reason:  IPPoolInvalidReasonNoPools,
+			Message: fmt.Sprintf("no IPPools are referenced for %s", strings.Join(noPools, ", ")),
+		})
+	case len(notFound) > 0:
+		conditions.Set(setter, conditions.Condition{
+			Type:    invalidType,
+			Status:  corev1.ConditionTrue,
+			Reason:  IPPoolInvalidReasonNotFound,
+			Message: fmt.Sprintf("IPPools not found: %s", strings.Join(notFound, ", ")),
+		})
+	case len(invalid) > 0:
+		conditions.Set(setter, conditions.Condition{
+			Type:    invalidType,
+			Status:  corev1.ConditionTrue,
+			Reason:  IPPoolInvalidReasonInvalid,
+			Message: fmt.Sprintf("IPPools are invalid: %s", strings.Join(invalid, ", ")),
+		})
+	default:
+		conditions.Delete(setter, invalidType)
+	}
+
+	pressure := conditions.Condition{
+		Type:    string(v1alpha1.VsphereDistributedNetworkIPPoolPressure),
+		Status:  corev1.ConditionFalse,
+		Reason:  IPPoolPressureReasonAvailable,
+		Message: strings.Join(free, ", "),
+	}
+	if low {
+		pressure.Status = corev1.ConditionTrue
+		pressure.Reason = IPPoolPressureReasonLow
+	}
+	conditions.Set(setter, pressure)
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+)
+
+// newPool returns an IPPool of count addresses from start, of which the first
+// allocated addresses are allocated.
+func newPool(name, start string, count, allocated int64) *v1alpha1.IPPool {
+	pool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: start, AddressCount: count},
+	}
+	ip, family, _ := ipaddr.Parse(start)
+	first := ipaddr.ToInt(ip)
+	for i := int64(0); i < allocated; i++ {
+		ip := ipaddr.FromInt(new(big.Int).Add(first, big.NewInt(i)), family)
+		pool.SetAllocation(ip.String(), v1alpha1.NetworkInterfaceReference{Namespace: "ns", Name: fmt.Sprintf("ni-%d", i)})
+	}
+	return pool
+}
+
+// reconcileNetwork reconciles the VSphereDistributedNetwork "vdn" of the given
+// objects, and returns its IPPoolInvalid and IPPoolPressure conditions.
+func reconcileNetwork(t *testing.T, objs ...runtime.Object) (invalid, pressure *conditions.Condition) {
+	t.Helper()
+	scheme, err := NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &vsphereDistributedNetworkReconciler{client: fake.NewFakeClientWithScheme(scheme, objs...)}
+	if _, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Name: "vdn"}}); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	network := &v1alpha1.VSphereDistributedNetwork{}
+	if err := r.client.Get(context.Background(), client.ObjectKey{Name: "vdn"}, network); err != nil {
+		t.Fatal(err)
+	}
+	setter, err := conditions.For(network)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conditions.Get(setter, string(v1alpha1.VSphereDistributedNetworkIPPoolInvalid)),
+		conditions.Get(setter, string(v1alpha1.VsphereDistributedNetworkIPPoolPressure))
+}
+
+func TestVSphereDistributedNetworkIPPoolConditions(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           v1alpha1.VSphereDistributedNetworkSpec
+		pools          []runtime.Object
+		invalidReason  string
+		pressureStatus corev1.ConditionStatus
+		pressureReason string
+	}{
+		{
+			name: "available",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}},
+			},
+			pools:          []runtime.Object{newPool("pool", "192.168.1.10", 10, 9)},
+			pressureStatus: corev1.ConditionFalse,
+			pressureReason: IPPoolPressureReasonAvailable,
+		},
+		{
+			name: "low on free addresses",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}},
+			},
+			pools:          []runtime.Object{newPool("pool", "192.168.1.10", 20, 19)},
+			pressureStatus: corev1.ConditionTrue,
+			pressureReason: IPPoolPressureReasonLow,
+		},
+		{
+			// The free addresses of all the pools of a family are counted.
+			name: "free addresses in another pool",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}, {Name: "pool2"}},
+			},
+			pools: []runtime.Object{
+				newPool("pool", "192.168.1.10", 10, 10),
+				newPool("pool2", "192.168.2.10", 10, 0),
+			},
+			pressureStatus: corev1.ConditionFalse,
+			pressureReason: IPPoolPressureReasonAvailable,
+		},
+		{
+			// The sizes of /64 pools add up to more than an int64.
+			name: "IPv6 pools",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPAssignmentMode: v1alpha1.IPAssignmentModeDHCP,
+				IPv6: &v1alpha1.IPv6Config{
+					IPPools: []v1alpha1.IPPoolReference{{Name: "pool6"}, {Name: "pool6-2"}},
+				},
+			},
+			pools: []runtime.Object{
+				&v1alpha1.IPPool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool6"},
+					Spec:       v1alpha1.IPPoolSpec{CIDRs: []string{"fd00:1::/64"}},
+				},
+				&v1alpha1.IPPool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool6-2"},
+					Spec:       v1alpha1.IPPoolSpec{CIDRs: []string{"fd00:2::/64"}},
+				},
+			},
+			pressureStatus: corev1.ConditionFalse,
+			pressureReason: IPPoolPressureReasonAvailable,
+		},
+		{
+			name: "no pools",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+			},
+			invalidReason:  IPPoolInvalidReasonNoPools,
+			pressureStatus: corev1.ConditionTrue,
+			pressureReason: IPPoolPressureReasonLow,
+		},
+		{
+			name: "pool not found",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}, {Name: "missing"}},
+			},
+			pools:          []runtime.Object{newPool("pool", "192.168.1.10", 10, 0)},
+			invalidReason:  IPPoolInvalidReasonNotFound,
+			pressureStatus: corev1.ConditionFalse,
+			pressureReason: IPPoolPressureReasonAvailable,
+		},
+		{
+			name: "invalid pool",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}},
+			},
+			pools: []runtime.Object{&v1alpha1.IPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1", AddressCount: 10},
+			}},
+			invalidReason:  IPPoolInvalidReasonInvalid,
+			pressureStatus: corev1.ConditionTrue,
+			pressureReason: IPPoolPressureReasonLow,
+		},
+		{
+			name: "pool of the other family",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}},
+				IPv6:    &v1alpha1.IPv6Config{IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}}},
+			},
+			pools:          []runtime.Object{newPool("pool", "192.168.1.10", 10, 0)},
+			invalidReason:  IPPoolInvalidReasonInvalid,
+			pressureStatus: corev1.ConditionTrue,
+			pressureReason: IPPoolPressureReasonLow,
+		},
+		{
+			name: "DHCP",
+			spec: v1alpha1.VSphereDistributedNetworkSpec{
+				IPAssignmentMode: v1alpha1.IPAssignmentModeDHCP,
+			},
+		},
+	}
+	for _, tt := range tests {
+		network := &v1alpha1.VSphereDistributedNetwork{ObjectMeta: metav1.ObjectMeta{Name: "vdn"}, Spec: tt.spec}
+		invalid, pressure := reconcileNetwork(t, append(tt.pools, network)...)
+
+		switch {
+		case tt.invalidReason == "" && invalid != nil:
+			t.Errorf("%s: IPPoolInvalid = %+v, want none", tt.name, invalid)
+		case tt.invalidReason != "" && (invalid == nil || invalid.Status != corev1.ConditionTrue || invalid.Reason != tt.invalidReason):
+			t.Errorf("%s: IPPoolInvalid = %+v, want True with reason %s", tt.name, invalid, tt.invalidReason)
+		}
+		switch {
+		case tt.pressureStatus == "" && pressure != nil:
+			t.Errorf("%s: IPPoolPressure = %+v, want none", tt.name, pressure)
+		case tt.pressureStatus != "" && (pressure == nil || pressure.Status != tt.pressureStatus || pressure.Reason != tt.pressureReason):
+			t.Errorf("%s: IPPoolPressure = %+v, want %s with reason %s", tt.name, pressure, tt.pressureStatus, tt.pressureReason)
+		}
+	}
+}
+
+func TestVSphereDistributedNetworkIPPoolPressureMessage(t *testing.T) {
+	network := &v1alpha1.VSphereDistributedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+		Spec: v1alpha1.VSphereDistributedNetworkSpec{
+			IPPools: []v1alpha1.IPPoolReference{{Name: "pool"}},
+			IPv6:    &v1alpha1.IPv6Config{IPPools: []v1alpha1.IPPoolReference{{Name: "pool6"}}},
+		},
+	}
+	_, pressure := reconcileNetwork(t,
+		newPool("pool", "192.168.1.10", 10, 2),
+		newPool("pool6", "fd00::10", 100, 95),
+		network)
+	want := "8 of 10 IPv4 addresses free, 5 of 100 IPv6 addresses free"
+	if pressure == nil || pressure.Status != corev1.ConditionTrue || pressure.Message != want {
+		t.Errorf("IPPoolPressure = %+v, want True with message %q", pressure, want)
+	}
+}
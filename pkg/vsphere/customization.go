@@ -0,0 +1,174 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/render"
+)
+
+// Interface is a realized NetworkInterface of a virtual machine together with
+// its Network.
+type Interface struct {
+	// NetworkInterface is the NetworkInterface. It must have a MAC address.
+	NetworkInterface *v1alpha1.NetworkInterface
+
+	// Network is the Network of the NetworkInterface, or nil if the
+	// NetworkInterface has no DNS settings.
+	Network *v1alpha1.Network
+
+	// Provider is the VSphereDistributedNetwork of the Network, or nil if the
+	// Network has another provider.
+	Provider *v1alpha1.VSphereDistributedNetwork
+}
+
+// CustomizationSpec returns the guest customization of a virtual machine
+// with the given identity and interfaces, in the order of the interfaces.
+func CustomizationSpec(identity types.BaseCustomizationIdentitySettings, interfaces []Interface) (*types.CustomizationSpec, error) {
+	spec := &types.CustomizationSpec{
+		Identity: identity,
+	}
+	for _, iface := range interfaces {
+		mapping, err := AdapterMapping(iface)
+		if err != nil {
+			return nil, err
+		}
+		spec.NicSettingMap = append(spec.NicSettingMap, mapping)
+
+		if iface.Network != nil {
+			spec.GlobalIPSettings.DnsServerList = appendMissing(spec.GlobalIPSettings.DnsServerList, iface.Network.Spec.DNS...)
+			spec.GlobalIPSettings.DnsSuffixList = appendMissing(spec.GlobalIPSettings.DnsSuffixList, iface.Network.Spec.DNSSearchDomains...)
+		}
+	}
+	return spec, nil
+}
+
+// LinuxPrep returns the identity of a Linux virtual machine with the given
+// host name and domain.
+func LinuxPrep(hostName, domain string) *types.CustomizationLinuxPrep {
+	return &types.CustomizationLinuxPrep{
+		HostName: &types.CustomizationFixedName{Name: hostName},
+		Domain:   domain,
+	}
+}
+
+// AdapterMapping returns the guest customization of the given interface.
+func AdapterMapping(iface Interface) (types.CustomizationAdapterMapping, error) {
+	ni := iface.NetworkInterface
+	if ni.Status.MacAddress == "" {
+		return types.CustomizationAdapterMapping{},
+			fmt.Errorf("NetworkInterface %s/%s does not have a MAC address", ni.Namespace, ni.Name)
+	}
+
+	settings, err := ipSettings(iface)
+	if err != nil {
+		return types.CustomizationAdapterMapping{},
+			fmt.Errorf("NetworkInterface %s/%s: %v", ni.Namespace, ni.Name, err)
+	}
+	for _, c := range ni.Status.IPConfigs {
+		settings.DnsServerList = appendMissing(settings.DnsServerList, c.Nameservers...)
+	}
+	if iface.Network != nil {
+		settings.DnsServerList = appendMissing(settings.DnsServerList, iface.Network.Spec.DNS...)
+		if len(iface.Network.Spec.DNSSearchDomains) > 0 {
+			settings.DnsDomain = iface.Network.Spec.DNSSearchDomains[0]
+		}
+	}
+	return types.CustomizationAdapterMapping{
+		MacAddress: ni.Status.MacAddress,
+		Adapter:    settings,
+	}, nil
+}
+
+// ipSettings returns the addresses of the interface's adapter.
+func ipSettings(iface Interface) (types.CustomizationIPSettings, error) {
+	settings := types.CustomizationIPSettings{
+		Ip: &types.CustomizationDhcpIpGenerator{},
+	}
+	var v6 *v1alpha1.IPv6Config
+	if iface.Provider != nil {
+		v6 = iface.Provider.Spec.IPv6
+		if v6 == nil && iface.Provider.Spec.IPAssignmentMode == v1alpha1.IPAssignmentModeDHCP {
+			return settings, nil
+		}
+	}
+
+	// The IPv6 addresses of a dual-stack network that are not assigned from a
+	// static pool are configured by the guest.
+	var v6Generator types.BaseCustomizationIpV6Generator
+	if v6 != nil {
+		switch v6.IPAssignmentMode {
+		case v1alpha1.IPAssignmentModeSLAAC:
+			v6Generator = &types.CustomizationAutoIpV6Generator{}
+		case v1alpha1.IPAssignmentModeDHCPv6:
+			v6Generator = &types.CustomizationDhcpIpV6Generator{}
+		}
+	}
+	if v6Generator != nil {
+		settings.IpV6Spec = &types.CustomizationIPSettingsIpV6AddressSpec{
+			Ip: []types.BaseCustomizationIpV6Generator{v6Generator},
+		}
+	}
+
+	for _, c := range iface.NetworkInterface.Status.IPConfigs {
+		ip := net.ParseIP(c.IP)
+		if ip == nil {
+			return settings, fmt.Errorf("invalid IP address %q", c.IP)
+		}
+
+		if ip.To4() != nil {
+			if _, ok := settings.Ip.(*types.CustomizationFixedIp); ok {
+				// The adapter has a single IPv4 address.
+				continue
+			}
+			settings.Ip = &types.CustomizationFixedIp{IpAddress: c.IP}
+			settings.SubnetMask = c.SubnetMask
+			if c.Gateway != "" {
+				settings.Gateway = []string{c.Gateway}
+			}
+			continue
+		}
+
+		if v6Generator != nil {
+			continue
+		}
+		prefixLength, err := render.PrefixLength(c.SubnetMask, 8*net.IPv6len)
+		if err != nil {
+			return settings, err
+		}
+		if settings.IpV6Spec == nil {
+			settings.IpV6Spec = &types.CustomizationIPSettingsIpV6AddressSpec{}
+		}
+		settings.IpV6Spec.Ip = append(settings.IpV6Spec.Ip, &types.CustomizationFixedIpV6{
+			IpAddress:  c.IP,
+			SubnetMask: int32(prefixLength),
+		})
+		if c.Gateway != "" && len(settings.IpV6Spec.Gateway) == 0 {
+			settings.IpV6Spec.Gateway = []string{c.Gateway}
+		}
+	}
+	return settings, nil
+}
+
+// appendMissing appends the values that s does not contain yet to s.
+func appendMissing(s []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, e := range s {
+			if e == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			s = append(s, v)
+		}
+	}
+	return s
+}
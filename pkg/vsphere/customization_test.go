@@ -0,0 +1,182 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+var (
+	ipv4Config = v1alpha1.IPConfig{
+		IP:          "192.168.1.10",
+		IPFamily:    "IPv4",
+		Gateway:     "192.168.1.1",
+		SubnetMask:  "255.255.255.0",
+		Nameservers: []string{"192.168.1.54"},
+	}
+	ipv6Config = v1alpha1.IPConfig{
+		IP:         "fd00::10",
+		IPFamily:   "IPv6",
+		Gateway:    "fd00::1",
+		SubnetMask: "ffff:ffff:ffff:ffff::",
+	}
+)
+
+func newInterface(mode v1alpha1.IPAssignmentModeType, v6 *v1alpha1.IPv6Config, ipConfigs ...v1alpha1.IPConfig) Interface {
+	return Interface{
+		NetworkInterface: &v1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns"},
+			Status: v1alpha1.NetworkInterfaceStatus{
+				MacAddress: "00:50:56:00:00:01",
+				IPConfigs:  ipConfigs,
+			},
+		},
+		Network: &v1alpha1.Network{
+			Spec: v1alpha1.NetworkSpec{
+				DNS:              []string{"192.168.1.53"},
+				DNSSearchDomains: []string{"example.com", "example.org"},
+			},
+		},
+		Provider: &v1alpha1.VSphereDistributedNetwork{
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{IPAssignmentMode: mode, IPv6: v6},
+		},
+	}
+}
+
+func TestAdapterMapping(t *testing.T) {
+	dns := []string{"192.168.1.53"}
+	fixedIPv4 := types.CustomizationIPSettings{
+		Ip:            &types.CustomizationFixedIp{IpAddress: "192.168.1.10"},
+		SubnetMask:    "255.255.255.0",
+		Gateway:       []string{"192.168.1.1"},
+		DnsServerList: []string{"192.168.1.54", "192.168.1.53"},
+		DnsDomain:     "example.com",
+	}
+	withIPv6 := func(settings types.CustomizationIPSettings, spec *types.CustomizationIPSettingsIpV6AddressSpec) types.CustomizationIPSettings {
+		settings.IpV6Spec = spec
+		return settings
+	}
+
+	tests := []struct {
+		name  string
+		iface Interface
+		want  types.CustomizationIPSettings
+	}{
+		{
+			name:  "DHCP",
+			iface: newInterface(v1alpha1.IPAssignmentModeDHCP, nil),
+			want: types.CustomizationIPSettings{
+				Ip:            &types.CustomizationDhcpIpGenerator{},
+				DnsServerList: dns,
+				DnsDomain:     "example.com",
+			},
+		},
+		{
+			name:  "static IPv4",
+			iface: newInterface(v1alpha1.IPAssignmentModeStaticPool, nil, ipv4Config),
+			want:  fixedIPv4,
+		},
+		{
+			name:  "without IPConfigs",
+			iface: newInterface(v1alpha1.IPAssignmentModeStaticPool, nil),
+			want: types.CustomizationIPSettings{
+				Ip:            &types.CustomizationDhcpIpGenerator{},
+				DnsServerList: dns,
+				DnsDomain:     "example.com",
+			},
+		},
+		{
+			name: "static IPv6",
+			iface: newInterface(v1alpha1.IPAssignmentModeStaticPool,
+				&v1alpha1.IPv6Config{IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool}, ipv4Config, ipv6Config),
+			want: withIPv6(fixedIPv4, &types.CustomizationIPSettingsIpV6AddressSpec{
+				Ip:      []types.BaseCustomizationIpV6Generator{&types.CustomizationFixedIpV6{IpAddress: "fd00::10", SubnetMask: 64}},
+				Gateway: []string{"fd00::1"},
+			}),
+		},
+		{
+			name: "SLAAC",
+			iface: newInterface(v1alpha1.IPAssignmentModeStaticPool,
+				&v1alpha1.IPv6Config{IPAssignmentMode: v1alpha1.IPAssignmentModeSLAAC}, ipv4Config, ipv6Config),
+			want: withIPv6(fixedIPv4, &types.CustomizationIPSettingsIpV6AddressSpec{
+				Ip: []types.BaseCustomizationIpV6Generator{&types.CustomizationAutoIpV6Generator{}},
+			}),
+		},
+		{
+			name: "DHCP and DHCPv6",
+			iface: newInterface(v1alpha1.IPAssignmentModeDHCP,
+				&v1alpha1.IPv6Config{IPAssignmentMode: v1alpha1.IPAssignmentModeDHCPv6}),
+			want: types.CustomizationIPSettings{
+				Ip: &types.CustomizationDhcpIpGenerator{},
+				IpV6Spec: &types.CustomizationIPSettingsIpV6AddressSpec{
+					Ip: []types.BaseCustomizationIpV6Generator{&types.CustomizationDhcpIpV6Generator{}},
+				},
+				DnsServerList: dns,
+				DnsDomain:     "example.com",
+			},
+		},
+	}
+	for _, tt := range tests {
+		mapping, err := AdapterMapping(tt.iface)
+		if err != nil {
+			t.Errorf("%s: AdapterMapping() = %v", tt.name, err)
+			continue
+		}
+		if mapping.MacAddress != "00:50:56:00:00:01" {
+			t.Errorf("%s: MacAddress = %q, want 00:50:56:00:00:01", tt.name, mapping.MacAddress)
+		}
+		if !reflect.DeepEqual(mapping.Adapter, tt.want) {
+			t.Errorf("%s: Adapter = %#v, want %#v", tt.name, mapping.Adapter, tt.want)
+		}
+	}
+}
+
+func TestAdapterMappingErrors(t *testing.T) {
+	iface := newInterface(v1alpha1.IPAssignmentModeStaticPool, nil)
+	iface.NetworkInterface.Status.MacAddress = ""
+	if _, err := AdapterMapping(iface); err == nil {
+		t.Errorf("AdapterMapping() of a NetworkInterface without a MAC address succeeded")
+	}
+
+	invalid := ipv4Config
+	invalid.IP = "192.168.1"
+	if _, err := AdapterMapping(newInterface(v1alpha1.IPAssignmentModeStaticPool, nil, invalid)); err == nil {
+		t.Errorf("AdapterMapping() of an invalid IP address succeeded")
+	}
+}
+
+func TestCustomizationSpec(t *testing.T) {
+	first := newInterface(v1alpha1.IPAssignmentModeStaticPool, nil, ipv4Config)
+	second := newInterface(v1alpha1.IPAssignmentModeDHCP, nil)
+	second.NetworkInterface.Status.MacAddress = "00:50:56:00:00:02"
+	second.Network.Spec.DNS = []string{"192.168.1.53", "10.10.1.53"}
+	second.Network.Spec.DNSSearchDomains = []string{"example.net"}
+
+	identity := LinuxPrep("vm", "example.com")
+	spec, err := CustomizationSpec(identity, []Interface{first, second})
+	if err != nil {
+		t.Fatalf("CustomizationSpec() = %v", err)
+	}
+	if spec.Identity != identity {
+		t.Errorf("Identity = %#v, want %#v", spec.Identity, identity)
+	}
+	if len(spec.NicSettingMap) != 2 ||
+		spec.NicSettingMap[0].MacAddress != "00:50:56:00:00:01" ||
+		spec.NicSettingMap[1].MacAddress != "00:50:56:00:00:02" {
+		t.Errorf("NicSettingMap = %#v, want the adapters in the order of the interfaces", spec.NicSettingMap)
+	}
+	want := types.CustomizationGlobalIPSettings{
+		DnsServerList: []string{"192.168.1.53", "10.10.1.53"},
+		DnsSuffixList: []string{"example.com", "example.org", "example.net"},
+	}
+	if !reflect.DeepEqual(spec.GlobalIPSettings, want) {
+		t.Errorf("GlobalIPSettings = %#v, want %#v", spec.GlobalIPSettings, want)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"fmt"
+
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// VirtualVmxnet3 returns the vmxnet3 device of the given NetworkInterface,
+// connected to a port of the distributed switch with the given UUID. The
+// VMXNET3NetworkInterface provider of the NetworkInterface sets the UPT and
+// wake-on-LAN flags of the device, both are disabled if it is nil.
+//
+// The key of the returned device is zero, callers adding it to a virtual
+// machine must assign it a unique negative key.
+func VirtualVmxnet3(
+	ni *v1alpha1.NetworkInterface,
+	provider *v1alpha1.VMXNET3NetworkInterface,
+	switchUUID string) (*types.VirtualVmxnet3, error) {
+
+	card, err := ethernetCard(ni, switchUUID)
+	if err != nil {
+		return nil, err
+	}
+	var spec v1alpha1.VMXNET3NetworkInterfaceSpec
+	if provider != nil {
+		spec = provider.Spec
+	}
+	card.UptCompatibilityEnabled = types.NewBool(spec.UPTCompatibilityEnabled)
+	card.WakeOnLanEnabled = types.NewBool(spec.WakeOnLanEnabled)
+
+	return &types.VirtualVmxnet3{
+		VirtualVmxnet: types.VirtualVmxnet{
+			VirtualEthernetCard: *card,
+		},
+	}, nil
+}
+
+// ethernetCard returns the ethernet card of the given NetworkInterface. The
+// card is backed by the port of the NetworkInterface in the portgroup of its
+// NetworkID, or by any port of that portgroup if the NetworkInterface has no
+// PortID. The card uses the MAC address of the NetworkInterface as a manual
+// address.
+func ethernetCard(ni *v1alpha1.NetworkInterface, switchUUID string) (*types.VirtualEthernetCard, error) {
+	if ni.Status.NetworkID == "" {
+		return nil, fmt.Errorf("NetworkInterface %s/%s does not have a network ID", ni.Namespace, ni.Name)
+	}
+	if ni.Status.MacAddress == "" {
+		return nil, fmt.Errorf("NetworkInterface %s/%s does not have a MAC address", ni.Namespace, ni.Name)
+	}
+
+	return &types.VirtualEthernetCard{
+		VirtualDevice: types.VirtualDevice{
+			Backing: &types.VirtualEthernetCardDistributedVirtualPortBackingInfo{
+				Port: types.DistributedVirtualSwitchPortConnection{
+					SwitchUuid:   switchUUID,
+					PortgroupKey: ni.Status.NetworkID,
+					PortKey:      ni.Status.PortID,
+				},
+			},
+			Connectable: &types.VirtualDeviceConnectInfo{
+				StartConnected:    true,
+				AllowGuestControl: true,
+				Connected:         true,
+			},
+		},
+		AddressType: string(types.VirtualEthernetCardMacTypeManual),
+		MacAddress:  ni.Status.MacAddress,
+		ExternalId:  ni.Status.ExternalID,
+	}, nil
+}
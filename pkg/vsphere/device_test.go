@@ -0,0 +1,118 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+func newRealizedInterface(networkID string) *v1alpha1.NetworkInterface {
+	return &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns"},
+		Status: v1alpha1.NetworkInterfaceStatus{
+			NetworkID:  networkID,
+			MacAddress: "00:50:56:00:00:01",
+			ExternalID: "external-id",
+		},
+	}
+}
+
+func TestVirtualVmxnet3Errors(t *testing.T) {
+	ni := newRealizedInterface("")
+	if _, err := VirtualVmxnet3(ni, nil, "uuid"); err == nil {
+		t.Errorf("VirtualVmxnet3() of a NetworkInterface without a network ID succeeded")
+	}
+	ni = newRealizedInterface("dvportgroup-1")
+	ni.Status.MacAddress = ""
+	if _, err := VirtualVmxnet3(ni, nil, "uuid"); err == nil {
+		t.Errorf("VirtualVmxnet3() of a NetworkInterface without a MAC address succeeded")
+	}
+}
+
+// TestVirtualVmxnet3Reconfigure adds the device of a NetworkInterface to a
+// simulated virtual machine and checks the device of the virtual machine.
+func TestVirtualVmxnet3Reconfigure(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		pg, err := finder.Network(ctx, "DC0_DVPG0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var portgroup mo.DistributedVirtualPortgroup
+		if err := pg.(*object.DistributedVirtualPortgroup).Properties(ctx, pg.Reference(),
+			[]string{"key", "config.distributedVirtualSwitch"}, &portgroup); err != nil {
+			t.Fatal(err)
+		}
+		var dvs mo.DistributedVirtualSwitch
+		if err := object.NewCommon(c, *portgroup.Config.DistributedVirtualSwitch).Properties(ctx,
+			*portgroup.Config.DistributedVirtualSwitch, []string{"uuid"}, &dvs); err != nil {
+			t.Fatal(err)
+		}
+
+		provider := &v1alpha1.VMXNET3NetworkInterface{
+			Spec: v1alpha1.VMXNET3NetworkInterfaceSpec{UPTCompatibilityEnabled: true, WakeOnLanEnabled: true},
+		}
+		device, err := VirtualVmxnet3(newRealizedInterface(portgroup.Key), provider, dvs.Uuid)
+		if err != nil {
+			t.Fatalf("VirtualVmxnet3() = %v", err)
+		}
+		device.Key = -1
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.AddDevice(ctx, device); err != nil {
+			t.Fatalf("AddDevice() = %v", err)
+		}
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var card *types.VirtualVmxnet3
+		for _, d := range devices.SelectByType((*types.VirtualVmxnet3)(nil)) {
+			if d.(*types.VirtualVmxnet3).MacAddress == "00:50:56:00:00:01" {
+				card = d.(*types.VirtualVmxnet3)
+			}
+		}
+		if card == nil {
+			t.Fatalf("devices of the virtual machine do not include the vmxnet3 device of the NetworkInterface")
+		}
+		if card.AddressType != string(types.VirtualEthernetCardMacTypeManual) {
+			t.Errorf("AddressType = %q, want %q", card.AddressType, types.VirtualEthernetCardMacTypeManual)
+		}
+		if card.UptCompatibilityEnabled == nil || !*card.UptCompatibilityEnabled {
+			t.Errorf("UptCompatibilityEnabled = %v, want true", card.UptCompatibilityEnabled)
+		}
+		if card.WakeOnLanEnabled == nil || !*card.WakeOnLanEnabled {
+			t.Errorf("WakeOnLanEnabled = %v, want true", card.WakeOnLanEnabled)
+		}
+		backing, ok := card.Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo)
+		if !ok {
+			t.Fatalf("Backing = %#v, want a distributed virtual port backing", card.Backing)
+		}
+		if backing.Port.SwitchUuid != dvs.Uuid || backing.Port.PortgroupKey != portgroup.Key {
+			t.Errorf("Backing.Port = %+v, want the port of switch %s in portgroup %s",
+				backing.Port, dvs.Uuid, portgroup.Key)
+		}
+	})
+}
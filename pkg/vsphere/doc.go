@@ -0,0 +1,27 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vsphere converts net-operator objects into the govmomi types used to
+// configure the network of vSphere virtual machines.
+//
+// CustomizationSpec maps the realized NetworkInterfaces of a virtual machine,
+// and their Networks, to the guest customization of the virtual machine. Each
+// NetworkInterface becomes a CustomizationAdapterMapping matched by the MAC
+// address in its status:
+//
+//   - If the provider of the Network is a VSphereDistributedNetwork in
+//     IPAssignmentModeDHCP, or the NetworkInterface has no IPConfigs, the
+//     adapter uses DHCP.
+//   - Otherwise, the adapter uses the IPv4 IPConfig as a fixed address, and the
+//     IPv6 IPConfigs as fixed IPv6 addresses.
+//   - On a dual-stack VSphereDistributedNetwork, IPAssignmentModeDHCP only
+//     applies to IPv4, and the IPv6 addresses of the adapter are
+//     autoconfigured in IPAssignmentModeSLAAC and obtained with DHCPv6 in
+//     IPAssignmentModeDHCPv6 instead of taken from the IPConfigs.
+//
+// The nameservers of the IPConfigs followed by the DNS servers of the Network,
+// and the search domains of the Network, are set on the adapters. The DNS
+// servers and search domains of the Networks are also set, without
+// duplicates, in the global IP settings. Guest customization has no static
+// routes, the routes of the IPConfigs are not part of the customization.
+package vsphere
@@ -0,0 +1,36 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package portgroup reconciles the vSphere Distributed PortGroups of
+// VSphereDistributedNetworks with vCenter Server using govmomi. The reconciler
+// only needs a vim25.Client, so it can run against the vcsim simulator of
+// govmomi as well as a real vCenter Server.
+//
+// The VSphereDistributedNetworkReconciler looks up the port group identified
+// by the PortGroupID of each network and sets the PortGroupFailure condition
+// if it does not exist or vCenter Server returned an error. When the network
+// declares a VLAN ID or VLAN trunk ranges, an MTU or a security policy, the
+// observed configuration of the port group is compared with the network:
+//
+//   - The VLAN and the security policy are settings of the port group. If they
+//     drifted, the port group is reconfigured to match the network and the
+//     PortGroupDrift condition is False with the Reconfigured reason, or True
+//     with the ReconfigureFailed reason if reconfiguring failed.
+//   - The MTU is a setting of the distributed switch of the port group, shared
+//     by all its port groups. The switch is not reconfigured, the
+//     PortGroupDrift condition is True with the Drifted reason while its MTU
+//     differs from the network.
+//
+// When the network has a PortGroupCreation and no PortGroupID, the port group
+// is created on the given distributed switch with the VLAN and security policy
+// of the network, and its identifier is written back to the PortGroupID of the
+// network. The VSphereDistributedNetworkPortGroupFinalizer is added to the
+// network so that the port group is deleted before the network is. The
+// PortGroupFailure condition is set with the CreateFailed or DeleteFailed
+// reason when creating or deleting the port group fails. The port groups of
+// networks without a PortGroupCreation are never created nor deleted.
+//
+// The settings that the network does not declare are left as configured in
+// vCenter Server. Networks are reconciled periodically to detect the changes
+// made to their port groups in vCenter Server.
+package portgroup
@@ -0,0 +1,476 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package portgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+)
+
+const (
+	// PortGroupFailureReasonNotFound is the reason set on a True
+	// PortGroupFailure condition when the port group does not exist.
+	PortGroupFailureReasonNotFound = "NotFound"
+	// PortGroupFailureReasonError is the reason set on a True
+	// PortGroupFailure condition when vCenter Server returned an error.
+	PortGroupFailureReasonError = "Error"
+	// PortGroupFailureReasonCreateFailed is the reason set on a True
+	// PortGroupFailure condition when the port group could not be created.
+	PortGroupFailureReasonCreateFailed = "CreateFailed"
+	// PortGroupFailureReasonDeleteFailed is the reason set on a True
+	// PortGroupFailure condition when the port group created for a deleted
+	// network could not be deleted.
+	PortGroupFailureReasonDeleteFailed = "DeleteFailed"
+
+	// PortGroupDriftReasonInSync is the reason set on a False PortGroupDrift
+	// condition when the port group matches the network.
+	PortGroupDriftReasonInSync = "InSync"
+	// PortGroupDriftReasonReconfigured is the reason set on a False
+	// PortGroupDrift condition when the port group drifted from the network
+	// and was reconfigured to match it.
+	PortGroupDriftReasonReconfigured = "Reconfigured"
+	// PortGroupDriftReasonDrifted is the reason set on a True PortGroupDrift
+	// condition when a setting of the distributed switch of the port group,
+	// which is not reconfigured, differs from the network.
+	PortGroupDriftReasonDrifted = "Drifted"
+	// PortGroupDriftReasonReconfigureFailed is the reason set on a True
+	// PortGroupDrift condition when the port group drifted from the network
+	// and could not be reconfigured.
+	PortGroupDriftReasonReconfigureFailed = "ReconfigureFailed"
+)
+
+const (
+	// requeueAfter is the delay after which a network whose port group could
+	// not be looked up or reconfigured is reconciled again.
+	requeueAfter = 30 * time.Second
+
+	// resyncPeriod is the delay after which a network is reconciled again to
+	// detect the changes made to its port group in vCenter Server.
+	resyncPeriod = 5 * time.Minute
+)
+
+// VSphereDistributedNetworkReconciler reconciles the port groups of
+// VSphereDistributedNetworks with vCenter Server. The scheme of the client
+// must include v1alpha1.
+type VSphereDistributedNetworkReconciler struct {
+	// Client is used to read and write the VSphereDistributedNetworks.
+	Client client.Client
+
+	// VimClient is the client of the vCenter Server of the port groups.
+	VimClient *vim25.Client
+}
+
+// SetupWithManager adds the reconciler to the given manager.
+func (r *VSphereDistributedNetworkReconciler) SetupWithManager(mgr manager.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("portgroup").
+		For(&v1alpha1.VSphereDistributedNetwork{}).
+		Complete(r)
+}
+
+// Reconcile reconciles the port group of the VSphereDistributedNetwork of the
+// given request and updates its PortGroupFailure and PortGroupDrift
+// conditions. The port group is created if the network asks for it, and
+// deleted when the network is deleted.
+func (r *VSphereDistributedNetworkReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	network := &v1alpha1.VSphereDistributedNetwork{}
+	if err := r.Client.Get(ctx, req.NamespacedName, network); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !network.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, network)
+	}
+	if network.Spec.PortGroupCreation != nil {
+		if !containsString(network.Finalizers, v1alpha1.VSphereDistributedNetworkPortGroupFinalizer) {
+			network.Finalizers = append(network.Finalizers, v1alpha1.VSphereDistributedNetworkPortGroupFinalizer)
+			if err := r.Client.Update(ctx, network); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if network.Spec.PortGroupID == "" {
+			id, err := r.createPortGroup(ctx, network)
+			if err != nil {
+				return r.markFailure(ctx, network, PortGroupFailureReasonCreateFailed, err)
+			}
+			network.Spec.PortGroupID = id
+			if err := r.Client.Update(ctx, network); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	orig := network.Status.DeepCopy()
+	setter, err := conditions.For(network)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	result := r.reconcilePortGroup(ctx, network, setter)
+	return result, r.updateStatus(ctx, network, orig)
+}
+
+// reconcileDelete deletes the port group created for the network and removes
+// the finalizer of the network.
+func (r *VSphereDistributedNetworkReconciler) reconcileDelete(
+	ctx context.Context,
+	network *v1alpha1.VSphereDistributedNetwork) (ctrl.Result, error) {
+
+	if !containsString(network.Finalizers, v1alpha1.VSphereDistributedNetworkPortGroupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+	if id := network.Spec.PortGroupID; id != "" {
+		if err := r.deletePortGroup(ctx, id); err != nil {
+			return r.markFailure(ctx, network, PortGroupFailureReasonDeleteFailed, err)
+		}
+	}
+
+	network.Finalizers = removeString(network.Finalizers, v1alpha1.VSphereDistributedNetworkPortGroupFinalizer)
+	return ctrl.Result{}, r.Client.Update(ctx, network)
+}
+
+// markFailure sets the PortGroupFailure condition of the network to True with
+// the given reason and error, and updates its status.
+func (r *VSphereDistributedNetworkReconciler) markFailure(
+	ctx context.Context,
+	network *v1alpha1.VSphereDistributedNetwork,
+	reason string,
+	failure error) (ctrl.Result, error) {
+
+	orig := network.Status.DeepCopy()
+	setter, err := conditions.For(network)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	conditions.Set(setter, conditions.Condition{
+		Type:    string(v1alpha1.VSphereDistributedNetworkPortGroupFailure),
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: failure.Error(),
+	})
+	return ctrl.Result{RequeueAfter: requeueAfter}, r.updateStatus(ctx, network, orig)
+}
+
+// updateStatus updates the status of the network if it differs from orig.
+func (r *VSphereDistributedNetworkReconciler) updateStatus(
+	ctx context.Context,
+	network *v1alpha1.VSphereDistributedNetwork,
+	orig *v1alpha1.VSphereDistributedNetworkStatus) error {
+
+	if equality.Semantic.DeepEqual(orig, &network.Status) {
+		return nil
+	}
+	if err := r.Client.Status().Update(ctx, network); err != nil && !apierrors.IsConflict(err) {
+		return err
+	}
+	return nil
+}
+
+// reconcilePortGroup looks up the port group of the network, reconfigures the
+// settings of the port group that drifted from the network, and sets the
+// conditions of the network accordingly.
+func (r *VSphereDistributedNetworkReconciler) reconcilePortGroup(
+	ctx context.Context,
+	network *v1alpha1.VSphereDistributedNetwork,
+	setter conditions.Setter) ctrl.Result {
+
+	failureType := string(v1alpha1.VSphereDistributedNetworkPortGroupFailure)
+	driftType := string(v1alpha1.VSphereDistributedNetworkPortGroupDrift)
+
+	pg, dvs, err := r.portGroup(ctx, network.Spec.PortGroupID)
+	if err != nil {
+		reason := PortGroupFailureReasonError
+		if isNotFound(err) {
+			reason = PortGroupFailureReasonNotFound
+		}
+		conditions.Set(setter, conditions.Condition{
+			Type:    failureType,
+			Status:  corev1.ConditionTrue,
+			Reason:  reason,
+			Message: err.Error(),
+		})
+		conditions.Delete(setter, driftType)
+		return ctrl.Result{RequeueAfter: requeueAfter}
+	}
+	conditions.Delete(setter, failureType)
+
+	if !declaresPortGroup(&network.Spec) {
+		conditions.Delete(setter, driftType)
+		return ctrl.Result{RequeueAfter: resyncPeriod}
+	}
+
+	var reconfigured, drifted []drift
+	for _, d := range portGroupDrift(&network.Spec, &pg.Config, dvs.Config) {
+		if d.onSwitch {
+			drifted = append(drifted, d)
+		} else {
+			reconfigured = append(reconfigured, d)
+		}
+	}
+	if len(reconfigured) > 0 {
+		if err := r.reconfigure(ctx, pg, &network.Spec); err != nil {
+			conditions.Set(setter, conditions.Condition{
+				Type:    driftType,
+				Status:  corev1.ConditionTrue,
+				Reason:  PortGroupDriftReasonReconfigureFailed,
+				Message: fmt.Sprintf("%s: %v", driftString(append(reconfigured, drifted...)), err),
+			})
+			return ctrl.Result{RequeueAfter: requeueAfter}
+		}
+	}
+
+	switch {
+	case len(drifted) > 0:
+		conditions.Set(setter, conditions.Condition{
+			Type:    driftType,
+			Status:  corev1.ConditionTrue,
+			Reason:  PortGroupDriftReasonDrifted,
+			Message: driftString(drifted),
+		})
+	case len(reconfigured) > 0:
+		conditions.Set(setter, conditions.Condition{
+			Type:    driftType,
+			Status:  corev1.ConditionFalse,
+			Reason:  PortGroupDriftReasonReconfigured,
+			Message: "reconfigured " + driftString(reconfigured),
+		})
+	default:
+		conditions.Set(setter, conditions.Condition{
+			Type:   driftType,
+			Status: corev1.ConditionFalse,
+			Reason: PortGroupDriftReasonInSync,
+		})
+	}
+	return ctrl.Result{RequeueAfter: resyncPeriod}
+}
+
+// portGroup retrieves the configuration of the port group with the given ID
+// and of its distributed switch.
+func (r *VSphereDistributedNetworkReconciler) portGroup(
+	ctx context.Context,
+	id string) (*mo.DistributedVirtualPortgroup, *mo.DistributedVirtualSwitch, error) {
+
+	if id == "" {
+		return nil, nil, errors.New("portGroupID is not set")
+	}
+	pc := property.DefaultCollector(r.VimClient)
+
+	pg := &mo.DistributedVirtualPortgroup{}
+	ref := types.ManagedObjectReference{Type: "DistributedVirtualPortgroup", Value: id}
+	if err := pc.RetrieveOne(ctx, ref, []string{"config"}, pg); err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve port group %q: %w", id, err)
+	}
+	if pg.Config.DistributedVirtualSwitch == nil {
+		return nil, nil, fmt.Errorf("port group %q is not on a distributed switch", id)
+	}
+
+	dvs := &mo.DistributedVirtualSwitch{}
+	if err := pc.RetrieveOne(ctx, *pg.Config.DistributedVirtualSwitch, []string{"config"}, dvs); err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve distributed switch of port group %q: %w", id, err)
+	}
+	return pg, dvs, nil
+}
+
+// reconfigure applies the VLAN and security policy declared by the spec to the
+// default port setting of the port group. The other settings of the port
+// group are repeated from its observed configuration so that they are kept by
+// vCenter Server implementations that replace the configuration.
+func (r *VSphereDistributedNetworkReconciler) reconfigure(
+	ctx context.Context,
+	pg *mo.DistributedVirtualPortgroup,
+	spec *v1alpha1.VSphereDistributedNetworkSpec) error {
+
+	config := types.DVPortgroupConfigSpec{
+		ConfigVersion:     pg.Config.ConfigVersion,
+		Name:              pg.Config.Name,
+		NumPorts:          pg.Config.NumPorts,
+		AutoExpand:        pg.Config.AutoExpand,
+		Type:              pg.Config.Type,
+		Description:       pg.Config.Description,
+		Policy:            pg.Config.Policy,
+		PortNameFormat:    pg.Config.PortNameFormat,
+		DefaultPortConfig: portSetting(spec, pg.Config.DefaultPortConfig),
+	}
+	task, err := object.NewDistributedVirtualPortgroup(r.VimClient, pg.Reference()).Reconfigure(ctx, config)
+	if err != nil {
+		return err
+	}
+	return task.Wait(ctx)
+}
+
+// createPortGroup creates the port group described by the PortGroupCreation
+// of the network, with the VLAN and security policy of the network, and
+// returns its identifier. The port group is described as the port group of
+// the network so that a port group created by an earlier reconciliation,
+// whose update of the network failed, is found and returned instead.
+func (r *VSphereDistributedNetworkReconciler) createPortGroup(
+	ctx context.Context,
+	network *v1alpha1.VSphereDistributedNetwork) (string, error) {
+
+	c := network.Spec.PortGroupCreation
+	name := c.Name
+	if name == "" {
+		name = network.Name
+	}
+	numPorts := c.NumPorts
+	if numPorts == 0 {
+		numPorts = v1alpha1.DefaultPortGroupNumPorts
+	}
+	description := portGroupDescription(network)
+
+	dvs, err := r.distributedSwitch(ctx, c.DistributedSwitchID)
+	if err != nil {
+		return "", err
+	}
+	if id, err := r.findPortGroup(ctx, dvs.Portgroup, name, description); err != nil || id != "" {
+		return id, err
+	}
+
+	spec := types.DVPortgroupConfigSpec{
+		Name:              name,
+		Description:       description,
+		Type:              string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding),
+		NumPorts:          numPorts,
+		AutoExpand:        types.NewBool(true),
+		DefaultPortConfig: portSetting(&network.Spec, nil),
+	}
+	task, err := object.NewDistributedVirtualSwitch(r.VimClient, dvs.Self).AddPortgroup(ctx, []types.DVPortgroupConfigSpec{spec})
+	if err == nil {
+		err = task.Wait(ctx)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create port group %q on distributed switch %q: %w", name, dvs.Self.Value, err)
+	}
+
+	if dvs, err = r.distributedSwitch(ctx, c.DistributedSwitchID); err != nil {
+		return "", err
+	}
+	id, err := r.findPortGroup(ctx, dvs.Portgroup, name, description)
+	if err == nil && id == "" {
+		err = fmt.Errorf("created port group %q not found on distributed switch %q", name, dvs.Self.Value)
+	}
+	return id, err
+}
+
+// distributedSwitch retrieves the port groups of the distributed switch with
+// the given identifier. The switch is looked up in the inventory because the
+// type of its reference, VmwareDistributedVirtualSwitch or a subtype of
+// DistributedVirtualSwitch, is not part of its identifier.
+func (r *VSphereDistributedNetworkReconciler) distributedSwitch(
+	ctx context.Context,
+	id string) (*mo.DistributedVirtualSwitch, error) {
+
+	kind := []string{"DistributedVirtualSwitch"}
+	v, err := view.NewManager(r.VimClient).CreateContainerView(ctx, r.VimClient.ServiceContent.RootFolder, kind, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up distributed switch %q: %w", id, err)
+	}
+	defer func() {
+		_ = v.Destroy(ctx)
+	}()
+
+	var switches []mo.DistributedVirtualSwitch
+	if err := v.Retrieve(ctx, kind, []string{"portgroup"}, &switches); err != nil {
+		return nil, fmt.Errorf("failed to look up distributed switch %q: %w", id, err)
+	}
+	for i := range switches {
+		if switches[i].Self.Value == id {
+			return &switches[i], nil
+		}
+	}
+	return nil, fmt.Errorf("distributed switch %q not found", id)
+}
+
+// findPortGroup returns the identifier of the port group among the given ones
+// with the given name and description, or an empty identifier if there is no
+// such port group.
+func (r *VSphereDistributedNetworkReconciler) findPortGroup(
+	ctx context.Context,
+	refs []types.ManagedObjectReference,
+	name, description string) (string, error) {
+
+	if len(refs) == 0 {
+		return "", nil
+	}
+	var pgs []mo.DistributedVirtualPortgroup
+	if err := property.DefaultCollector(r.VimClient).Retrieve(ctx, refs, []string{"config"}, &pgs); err != nil {
+		return "", fmt.Errorf("failed to retrieve port groups: %w", err)
+	}
+	for _, pg := range pgs {
+		if pg.Config.Name == name && pg.Config.Description == description {
+			return pg.Self.Value, nil
+		}
+	}
+	return "", nil
+}
+
+// deletePortGroup deletes the port group with the given identifier. A port
+// group that does not exist is not an error.
+func (r *VSphereDistributedNetworkReconciler) deletePortGroup(ctx context.Context, id string) error {
+	ref := types.ManagedObjectReference{Type: "DistributedVirtualPortgroup", Value: id}
+	task, err := object.NewDistributedVirtualPortgroup(r.VimClient, ref).Destroy(ctx)
+	if err == nil {
+		err = task.Wait(ctx)
+	}
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to delete port group %q: %w", id, err)
+	}
+	return nil
+}
+
+// portGroupDescription returns the description of the port group created for
+// the network.
+func portGroupDescription(network *v1alpha1.VSphereDistributedNetwork) string {
+	return fmt.Sprintf("Port group of VSphereDistributedNetwork %s (%s)", network.Name, network.UID)
+}
+
+// isNotFound returns true if err is, or wraps, a ManagedObjectNotFound fault.
+func isNotFound(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if soap.IsSoapFault(err) {
+			_, ok := soap.ToSoapFault(err).VimFault().(types.ManagedObjectNotFound)
+			return ok
+		}
+	}
+	return false
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(s []string, v string) []string {
+	var out []string
+	for _, e := range s {
+		if e != v {
+			out = append(out, e)
+		}
+	}
+	return out
+}
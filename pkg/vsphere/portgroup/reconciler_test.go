@@ -0,0 +1,307 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package portgroup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/vsphere/portgroup"
+)
+
+// newReconciler returns a reconciler of the given networks with the simulated
+// vCenter Server of c.
+func newReconciler(t *testing.T, c *vim25.Client, objs ...runtime.Object) *portgroup.VSphereDistributedNetworkReconciler {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return &portgroup.VSphereDistributedNetworkReconciler{
+		Client:    fake.NewFakeClientWithScheme(scheme, objs...),
+		VimClient: c,
+	}
+}
+
+// reconcile reconciles the network with the given name and returns it.
+func reconcile(t *testing.T, r *portgroup.VSphereDistributedNetworkReconciler, name string) *v1alpha1.VSphereDistributedNetwork {
+	if _, err := r.Reconcile(ctrl.Request{NamespacedName: ktypes.NamespacedName{Name: name}}); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+	network := &v1alpha1.VSphereDistributedNetwork{}
+	if err := r.Client.Get(context.Background(), client.ObjectKey{Name: name}, network); err != nil {
+		t.Fatal(err)
+	}
+	return network
+}
+
+// condition returns the condition of the network with the given type, or nil.
+func condition(
+	network *v1alpha1.VSphereDistributedNetwork,
+	conditionType v1alpha1.VSphereDistributedNetworkConditionType) *v1alpha1.VSphereDistributedNetworkCondition {
+
+	for i := range network.Status.Conditions {
+		if c := &network.Status.Conditions[i]; c.Type == conditionType {
+			return c
+		}
+	}
+	return nil
+}
+
+// expectCondition fails the test unless the network has a condition of the
+// given type with the given status and reason.
+func expectCondition(
+	t *testing.T,
+	network *v1alpha1.VSphereDistributedNetwork,
+	conditionType v1alpha1.VSphereDistributedNetworkConditionType,
+	status corev1.ConditionStatus,
+	reason string) {
+
+	t.Helper()
+	c := condition(network, conditionType)
+	if c == nil {
+		t.Errorf("%s condition is not set, want %s with reason %s", conditionType, status, reason)
+		return
+	}
+	if c.Status != status || c.Reason != reason {
+		t.Errorf("%s condition = %s with reason %s (%s), want %s with reason %s",
+			conditionType, c.Status, c.Reason, c.Message, status, reason)
+	}
+}
+
+// findPortGroup returns the simulated port group with the given name.
+func findPortGroup(ctx context.Context, t *testing.T, c *vim25.Client, name string) *object.DistributedVirtualPortgroup {
+	finder := find.NewFinder(c)
+	dc, err := finder.DefaultDatacenter(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finder.SetDatacenter(dc)
+	pg, err := finder.Network(ctx, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pg.(*object.DistributedVirtualPortgroup)
+}
+
+// portSetting returns the default port setting of the given port group.
+func portSetting(ctx context.Context, t *testing.T, pg *object.DistributedVirtualPortgroup) *types.VMwareDVSPortSetting {
+	var config mo.DistributedVirtualPortgroup
+	if err := pg.Properties(ctx, pg.Reference(), []string{"config"}, &config); err != nil {
+		t.Fatal(err)
+	}
+	setting, _ := config.Config.DefaultPortConfig.(*types.VMwareDVSPortSetting)
+	if setting == nil {
+		t.Fatalf("DefaultPortConfig = %#v, want a VMware port setting", config.Config.DefaultPortConfig)
+	}
+	return setting
+}
+
+func newNetwork(portGroupID string) *v1alpha1.VSphereDistributedNetwork {
+	return &v1alpha1.VSphereDistributedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "vdn", UID: "vdn-uid"},
+		Spec: v1alpha1.VSphereDistributedNetworkSpec{
+			PortGroupID:      portGroupID,
+			IPAssignmentMode: v1alpha1.IPAssignmentModeDHCP,
+		},
+	}
+}
+
+func TestReconcilePortGroupNotFound(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		r := newReconciler(t, c, newNetwork("dvportgroup-missing"))
+		network := reconcile(t, r, "vdn")
+		expectCondition(t, network, v1alpha1.VSphereDistributedNetworkPortGroupFailure,
+			corev1.ConditionTrue, portgroup.PortGroupFailureReasonNotFound)
+		if c := condition(network, v1alpha1.VSphereDistributedNetworkPortGroupDrift); c != nil {
+			t.Errorf("PortGroupDrift condition = %+v, want none for a missing port group", c)
+		}
+	})
+}
+
+func TestReconcilePortGroupUndeclared(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pg := findPortGroup(ctx, t, c, "DC0_DVPG0")
+		r := newReconciler(t, c, newNetwork(pg.Reference().Value))
+		network := reconcile(t, r, "vdn")
+		if len(network.Status.Conditions) != 0 {
+			t.Errorf("conditions = %+v, want none for a network that declares no port group setting",
+				network.Status.Conditions)
+		}
+	})
+}
+
+// TestReconcilePortGroupDrift tests that the VLAN and security policy of a
+// port group are reconfigured to match its network, and that the MTU of its
+// switch is only reported as drifted.
+func TestReconcilePortGroupDrift(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pg := findPortGroup(ctx, t, c, "DC0_DVPG0")
+		vlanID := int32(10)
+		allow := true
+		network := newNetwork(pg.Reference().Value)
+		network.Spec.VLANID = &vlanID
+		network.Spec.MTU = 9000
+		network.Spec.Security = &v1alpha1.PortGroupSecurity{ForgedTransmits: &allow}
+		r := newReconciler(t, c, network)
+
+		network = reconcile(t, r, "vdn")
+		expectCondition(t, network, v1alpha1.VSphereDistributedNetworkPortGroupDrift,
+			corev1.ConditionTrue, portgroup.PortGroupDriftReasonDrifted)
+		if c := condition(network, v1alpha1.VSphereDistributedNetworkPortGroupFailure); c != nil {
+			t.Errorf("PortGroupFailure condition = %+v, want none", c)
+		}
+		setting := portSetting(ctx, t, pg)
+		if vlan, ok := setting.Vlan.(*types.VmwareDistributedVirtualSwitchVlanIdSpec); !ok || vlan.VlanId != vlanID {
+			t.Errorf("Vlan = %#v, want VLAN ID %d", setting.Vlan, vlanID)
+		}
+		if p := setting.SecurityPolicy; p == nil || p.ForgedTransmits == nil || p.ForgedTransmits.Value == nil ||
+			!*p.ForgedTransmits.Value {
+			t.Errorf("SecurityPolicy = %+v, want forged transmits allowed", setting.SecurityPolicy)
+		}
+
+		// The port group is in sync once the network no longer declares an
+		// MTU that differs from its switch.
+		network.Spec.MTU = 0
+		if err := r.Client.Update(ctx, network); err != nil {
+			t.Fatal(err)
+		}
+		network = reconcile(t, r, "vdn")
+		expectCondition(t, network, v1alpha1.VSphereDistributedNetworkPortGroupDrift,
+			corev1.ConditionFalse, portgroup.PortGroupDriftReasonInSync)
+
+		// A change made in vCenter Server is reverted.
+		var config mo.DistributedVirtualPortgroup
+		if err := pg.Properties(ctx, pg.Reference(), []string{"config"}, &config); err != nil {
+			t.Fatal(err)
+		}
+		setting.Vlan = &types.VmwareDistributedVirtualSwitchVlanIdSpec{VlanId: 20}
+		task, err := pg.Reconfigure(ctx, types.DVPortgroupConfigSpec{
+			ConfigVersion:     config.Config.ConfigVersion,
+			Name:              config.Config.Name,
+			NumPorts:          config.Config.NumPorts,
+			Type:              config.Config.Type,
+			DefaultPortConfig: setting,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		network = reconcile(t, r, "vdn")
+		expectCondition(t, network, v1alpha1.VSphereDistributedNetworkPortGroupDrift,
+			corev1.ConditionFalse, portgroup.PortGroupDriftReasonReconfigured)
+		if vlan, ok := portSetting(ctx, t, pg).Vlan.(*types.VmwareDistributedVirtualSwitchVlanIdSpec); !ok ||
+			vlan.VlanId != vlanID {
+			t.Errorf("Vlan = %#v after reconfiguring, want VLAN ID %d", vlan, vlanID)
+		}
+	})
+}
+
+// distributedSwitchID returns the identifier of the simulated distributed
+// switch of the port group with the given name.
+func distributedSwitchID(ctx context.Context, t *testing.T, c *vim25.Client, name string) string {
+	pg := findPortGroup(ctx, t, c, name)
+	var config mo.DistributedVirtualPortgroup
+	if err := pg.Properties(ctx, pg.Reference(), []string{"config.distributedVirtualSwitch"}, &config); err != nil {
+		t.Fatal(err)
+	}
+	return config.Config.DistributedVirtualSwitch.Value
+}
+
+// TestReconcilePortGroupCreateDelete tests that the port group of a network
+// with a PortGroupCreation is created once, and deleted with the network.
+func TestReconcilePortGroupCreateDelete(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vlanID := int32(30)
+		network := newNetwork("")
+		network.Spec.VLANID = &vlanID
+		network.Spec.PortGroupCreation = &v1alpha1.PortGroupCreation{
+			DistributedSwitchID: distributedSwitchID(ctx, t, c, "DC0_DVPG0"),
+			Name:                "tenant",
+		}
+		r := newReconciler(t, c, network)
+
+		network = reconcile(t, r, "vdn")
+		id := network.Spec.PortGroupID
+		if id == "" {
+			t.Fatalf("PortGroupID is not set, conditions = %+v", network.Status.Conditions)
+		}
+		if len(network.Finalizers) != 1 || network.Finalizers[0] != v1alpha1.VSphereDistributedNetworkPortGroupFinalizer {
+			t.Errorf("finalizers = %v, want %s", network.Finalizers, v1alpha1.VSphereDistributedNetworkPortGroupFinalizer)
+		}
+		expectCondition(t, network, v1alpha1.VSphereDistributedNetworkPortGroupDrift,
+			corev1.ConditionFalse, portgroup.PortGroupDriftReasonInSync)
+
+		pg := findPortGroup(ctx, t, c, "tenant")
+		if pg.Reference().Value != id {
+			t.Errorf("PortGroupID = %q, want the created port group %q", id, pg.Reference().Value)
+		}
+		if vlan, ok := portSetting(ctx, t, pg).Vlan.(*types.VmwareDistributedVirtualSwitchVlanIdSpec); !ok ||
+			vlan.VlanId != vlanID {
+			t.Errorf("Vlan = %#v, want VLAN ID %d", vlan, vlanID)
+		}
+
+		// The port group created by a reconciliation whose update of the
+		// network failed is found instead of created again.
+		network.Spec.PortGroupID = ""
+		if err := r.Client.Update(ctx, network); err != nil {
+			t.Fatal(err)
+		}
+		if network = reconcile(t, r, "vdn"); network.Spec.PortGroupID != id {
+			t.Errorf("PortGroupID = %q, want the port group %q created earlier", network.Spec.PortGroupID, id)
+		}
+
+		now := metav1.Now()
+		network.DeletionTimestamp = &now
+		if err := r.Client.Update(ctx, network); err != nil {
+			t.Fatal(err)
+		}
+		if network = reconcile(t, r, "vdn"); len(network.Finalizers) != 0 {
+			t.Errorf("finalizers = %v, want none once the port group is deleted", network.Finalizers)
+		}
+		if _, err := find.NewFinder(c).Network(ctx, "tenant"); err == nil {
+			t.Errorf("port group of the deleted network still exists")
+		}
+
+		// A port group that no longer exists does not block the deletion.
+		network.Finalizers = []string{v1alpha1.VSphereDistributedNetworkPortGroupFinalizer}
+		if err := r.Client.Update(ctx, network); err != nil {
+			t.Fatal(err)
+		}
+		if network = reconcile(t, r, "vdn"); len(network.Finalizers) != 0 {
+			t.Errorf("finalizers = %v, want none when the port group was already deleted", network.Finalizers)
+		}
+	})
+}
+
+func TestReconcilePortGroupCreateFailed(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		network := newNetwork("")
+		network.Spec.PortGroupCreation = &v1alpha1.PortGroupCreation{DistributedSwitchID: "dvs-missing"}
+		r := newReconciler(t, c, network)
+
+		network = reconcile(t, r, "vdn")
+		expectCondition(t, network, v1alpha1.VSphereDistributedNetworkPortGroupFailure,
+			corev1.ConditionTrue, portgroup.PortGroupFailureReasonCreateFailed)
+		if network.Spec.PortGroupID != "" {
+			t.Errorf("PortGroupID = %q, want none when the port group could not be created", network.Spec.PortGroupID)
+		}
+	})
+}
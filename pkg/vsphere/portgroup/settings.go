@@ -0,0 +1,212 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package portgroup
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// DefaultMTU is the MTU of a distributed switch whose MTU is not set.
+const DefaultMTU = 1500
+
+// drift is a setting of a port group, or of its distributed switch, whose
+// observed value differs from the value declared by a network.
+type drift struct {
+	// setting is the path of the setting in the spec of the network.
+	setting  string
+	observed string
+	desired  string
+	// onSwitch is true if the setting is one of the distributed switch,
+	// which is shared by its port groups and is not reconfigured.
+	onSwitch bool
+}
+
+func (d drift) String() string {
+	return fmt.Sprintf("%s is %s, expected %s", d.setting, d.observed, d.desired)
+}
+
+// driftString returns the given drifts separated by semicolons.
+func driftString(drifts []drift) string {
+	s := make([]string, 0, len(drifts))
+	for _, d := range drifts {
+		s = append(s, d.String())
+	}
+	return strings.Join(s, "; ")
+}
+
+// declaresPortGroup returns true if the spec declares any setting of its port
+// group.
+func declaresPortGroup(spec *v1alpha1.VSphereDistributedNetworkSpec) bool {
+	return spec.VLANID != nil || len(spec.VLANTrunkRanges) > 0 || spec.MTU != 0 || spec.Security != nil
+}
+
+// portGroupDrift returns the settings declared by the spec whose values in the
+// given configurations of a port group and of its distributed switch differ
+// from the spec.
+func portGroupDrift(
+	spec *v1alpha1.VSphereDistributedNetworkSpec,
+	pg *types.DVPortgroupConfigInfo,
+	dvs types.BaseDVSConfigInfo) []drift {
+
+	var drifts []drift
+	setting, _ := pg.DefaultPortConfig.(*types.VMwareDVSPortSetting)
+	if setting == nil {
+		setting = &types.VMwareDVSPortSetting{}
+	}
+
+	if desired := vlanSpec(spec); desired != nil {
+		if observed, want := vlanString(setting.Vlan), vlanString(desired); observed != want {
+			path := "vlanID"
+			if spec.VLANID == nil {
+				path = "vlanTrunkRanges"
+			}
+			drifts = append(drifts, drift{setting: path, observed: observed, desired: want})
+		}
+	}
+
+	if s := spec.Security; s != nil {
+		policy := setting.SecurityPolicy
+		if policy == nil {
+			policy = &types.DVSSecurityPolicy{}
+		}
+		for _, p := range []struct {
+			path     string
+			desired  *bool
+			observed *types.BoolPolicy
+		}{
+			{"security.allowPromiscuous", s.AllowPromiscuous, policy.AllowPromiscuous},
+			{"security.macChanges", s.MACChanges, policy.MacChanges},
+			{"security.forgedTransmits", s.ForgedTransmits, policy.ForgedTransmits},
+		} {
+			if p.desired == nil {
+				continue
+			}
+			if observed, want := boolPolicyString(p.observed), strconv.FormatBool(*p.desired); observed != want {
+				drifts = append(drifts, drift{setting: p.path, observed: observed, desired: want})
+			}
+		}
+	}
+
+	if spec.MTU != 0 {
+		mtu := int32(DefaultMTU)
+		if config, ok := dvs.(*types.VMwareDVSConfigInfo); ok && config.MaxMtu != 0 {
+			mtu = config.MaxMtu
+		}
+		if mtu != spec.MTU {
+			drifts = append(drifts, drift{
+				setting:  "mtu",
+				observed: strconv.Itoa(int(mtu)),
+				desired:  strconv.Itoa(int(spec.MTU)),
+				onSwitch: true,
+			})
+		}
+	}
+	return drifts
+}
+
+// portSetting returns the given default port setting of a port group with the
+// VLAN and security policy declared by the spec applied to it.
+func portSetting(
+	spec *v1alpha1.VSphereDistributedNetworkSpec,
+	observed types.BaseDVPortSetting) *types.VMwareDVSPortSetting {
+
+	setting := &types.VMwareDVSPortSetting{}
+	if s, ok := observed.(*types.VMwareDVSPortSetting); ok && s != nil {
+		*setting = *s
+	}
+	if vlan := vlanSpec(spec); vlan != nil {
+		setting.Vlan = vlan
+	}
+	if s := spec.Security; s != nil {
+		policy := &types.DVSSecurityPolicy{}
+		if setting.SecurityPolicy != nil {
+			*policy = *setting.SecurityPolicy
+		}
+		if s.AllowPromiscuous != nil {
+			policy.AllowPromiscuous = &types.BoolPolicy{Value: types.NewBool(*s.AllowPromiscuous)}
+		}
+		if s.MACChanges != nil {
+			policy.MacChanges = &types.BoolPolicy{Value: types.NewBool(*s.MACChanges)}
+		}
+		if s.ForgedTransmits != nil {
+			policy.ForgedTransmits = &types.BoolPolicy{Value: types.NewBool(*s.ForgedTransmits)}
+		}
+		setting.SecurityPolicy = policy
+	}
+	return setting
+}
+
+// vlanSpec returns the VLAN spec declared by the network, or nil if it
+// declares neither a VLAN ID nor VLAN trunk ranges.
+func vlanSpec(spec *v1alpha1.VSphereDistributedNetworkSpec) types.BaseVmwareDistributedVirtualSwitchVlanSpec {
+	if spec.VLANID != nil {
+		return &types.VmwareDistributedVirtualSwitchVlanIdSpec{VlanId: *spec.VLANID}
+	}
+	if len(spec.VLANTrunkRanges) == 0 {
+		return nil
+	}
+	trunk := &types.VmwareDistributedVirtualSwitchTrunkVlanSpec{}
+	for _, r := range spec.VLANTrunkRanges {
+		trunk.VlanId = append(trunk.VlanId, types.NumericRange{Start: r.Start, End: r.End})
+	}
+	return trunk
+}
+
+// vlanString returns a canonical representation of the given VLAN spec, ex.
+// "10" or "trunk 1-10,20". The ranges of a trunk are sorted and merged.
+func vlanString(vlan types.BaseVmwareDistributedVirtualSwitchVlanSpec) string {
+	switch v := vlan.(type) {
+	case *types.VmwareDistributedVirtualSwitchVlanIdSpec:
+		return strconv.Itoa(int(v.VlanId))
+	case *types.VmwareDistributedVirtualSwitchTrunkVlanSpec:
+		return "trunk " + rangesString(v.VlanId)
+	case *types.VmwareDistributedVirtualSwitchPvlanSpec:
+		return "private VLAN " + strconv.Itoa(int(v.PvlanId))
+	default:
+		return "unset"
+	}
+}
+
+// rangesString returns the given ranges sorted, with overlapping and adjacent
+// ranges merged, ex. "1-10,20".
+func rangesString(ranges []types.NumericRange) string {
+	sorted := append([]types.NumericRange(nil), ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	var merged []types.NumericRange
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && r.Start <= merged[n-1].End+1 {
+			if r.End > merged[n-1].End {
+				merged[n-1].End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	s := make([]string, 0, len(merged))
+	for _, r := range merged {
+		if r.Start == r.End {
+			s = append(s, strconv.Itoa(int(r.Start)))
+		} else {
+			s = append(s, fmt.Sprintf("%d-%d", r.Start, r.End))
+		}
+	}
+	return strings.Join(s, ",")
+}
+
+// boolPolicyString returns the value of the given policy, or "unset".
+func boolPolicyString(p *types.BoolPolicy) string {
+	if p == nil || p.Value == nil {
+		return "unset"
+	}
+	return strconv.FormatBool(*p.Value)
+}
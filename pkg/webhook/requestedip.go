@@ -0,0 +1,185 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/internal/ipaddr"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipam"
+	"github.com/vmware-tanzu/net-operator-api/pkg/resolver"
+)
+
+// +kubebuilder:webhook:path=/validate-netoperator-vmware-com-v1alpha1-networkinterface-requestedips,mutating=false,failurePolicy=fail,groups=netoperator.vmware.com,resources=networkinterfaces,verbs=create;update,versions=v1alpha1,sideEffects=None,admissionReviewVersions=v1beta1,name=vrequestedips.networkinterface.netoperator.vmware.com
+
+// ValidateRequestedIPsPath is the path at which the RequestedIPValidator is
+// served.
+const ValidateRequestedIPsPath = "/validate-netoperator-vmware-com-v1alpha1-networkinterface-requestedips"
+
+// RequestedIPValidator validates that the addresses requested by a
+// NetworkInterface are within the IPPools of its network and not allocated to
+// another NetworkInterface. Unlike the other validating webhooks, it looks up
+// objects other than the validated one, so it is not part of the
+// NetworkInterface type.
+//
+// NetworkInterfaces whose network, or its provider, does not exist yet are
+// allowed, their addresses are checked again when they are allocated.
+type RequestedIPValidator struct {
+	// Client reads the Networks, their providers and the IPPools. It should
+	// not read from a cache, so that allocations made since the last
+	// reconciliation are seen.
+	Client client.Reader
+
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &RequestedIPValidator{}
+
+// InjectDecoder injects the decoder of the requests.
+func (v *RequestedIPValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Handle validates the requested IP addresses of the NetworkInterface of the
+// given request.
+func (v *RequestedIPValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update {
+		return admission.Allowed("")
+	}
+	ni := &v1alpha1.NetworkInterface{}
+	if err := v.decoder.Decode(req, ni); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if req.Operation == admissionv1beta1.Update {
+		old := &v1alpha1.NetworkInterface{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// Only changed requests are validated, the addresses of a
+		// NetworkInterface remain allocated to it.
+		if equalRequestedIPConfigs(ni.Spec.RequestedIPConfigs, old.Spec.RequestedIPConfigs) &&
+			ni.Spec.NetworkName == old.Spec.NetworkName {
+			return admission.Allowed("")
+		}
+	}
+
+	errs, err := v.validate(ctx, ni)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(errs) > 0 {
+		gk := v1alpha1.SchemeGroupVersion.WithKind("NetworkInterface").GroupKind()
+		return admission.Denied(apierrors.NewInvalid(gk, ni.Name, errs).Error())
+	}
+	return admission.Allowed("")
+}
+
+// validate returns the errors of the requested IP addresses of the
+// NetworkInterface, or an error if the IPPools could not be read.
+func (v *RequestedIPValidator) validate(ctx context.Context, ni *v1alpha1.NetworkInterface) (field.ErrorList, error) {
+	if len(ni.Spec.RequestedIPConfigs) == 0 || ni.Spec.NetworkName == "" ||
+		metav1.HasAnnotation(ni.ObjectMeta, v1alpha1.IPAMDisabledAnnotationKeyName) {
+		return nil, nil
+	}
+
+	network := &v1alpha1.Network{}
+	if err := v.Client.Get(ctx, client.ObjectKey{Namespace: ni.Namespace, Name: ni.Spec.NetworkName}, network); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if metav1.HasAnnotation(network.ObjectMeta, v1alpha1.IPAMDisabledAnnotationKeyName) {
+		return nil, nil
+	}
+	provider, err := resolver.New(v.Client).NetworkProvider(ctx, network)
+	if err != nil {
+		if resolver.IsNotFound(err) || resolver.IsUnknownKind(err) || resolver.IsVersionMismatch(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	vdn, ok := provider.(*v1alpha1.VSphereDistributedNetwork)
+	if !ok {
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("spec", "requestedIPConfigs")
+	for i, c := range ni.Spec.RequestedIPConfigs {
+		ipPath := fldPath.Index(i).Child("ip")
+		_, family, ok := ipaddr.Parse(c.IP)
+		if !ok {
+			// Invalid addresses are rejected by the NetworkInterface webhook.
+			continue
+		}
+		refs, static := poolRefs(vdn, family)
+		if !static {
+			allErrs = append(allErrs, field.Invalid(ipPath, c.IP,
+				"the "+string(family)+" addresses of network "+network.Name+" are not assigned from IPPools"))
+			continue
+		}
+		pools, err := v.pools(ctx, refs)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := ipam.PoolForRequestedIP(pools, c.IP, ni); err != nil {
+			if errors.Is(err, ipam.ErrNotInPool) || errors.Is(err, ipam.ErrAllocated) {
+				allErrs = append(allErrs, field.Invalid(ipPath, c.IP, err.Error()))
+				continue
+			}
+			return nil, err
+		}
+	}
+	return allErrs, nil
+}
+
+// poolRefs returns the IPPools from which the addresses of the given family
+// are assigned on the network, and false if they are not assigned from
+// IPPools.
+func poolRefs(network *v1alpha1.VSphereDistributedNetwork, family corev1.IPFamily) ([]v1alpha1.IPPoolReference, bool) {
+	v6 := network.Spec.IPv6
+	if family == corev1.IPv6Protocol && v6 != nil {
+		mode := v6.IPAssignmentMode
+		return v6.IPPools, mode == "" || mode == v1alpha1.IPAssignmentModeStaticPool
+	}
+	return network.Spec.IPPools, network.Spec.IPAssignmentMode != v1alpha1.IPAssignmentModeDHCP
+}
+
+// pools returns the referenced IPPools that exist.
+func (v *RequestedIPValidator) pools(ctx context.Context, refs []v1alpha1.IPPoolReference) ([]*v1alpha1.IPPool, error) {
+	var pools []*v1alpha1.IPPool
+	for _, ref := range refs {
+		pool := &v1alpha1.IPPool{}
+		if err := v.Client.Get(ctx, client.ObjectKey{Name: ref.Name}, pool); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+func equalRequestedIPConfigs(a, b []v1alpha1.RequestedIPConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
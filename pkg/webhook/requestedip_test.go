@@ -0,0 +1,141 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/webhook"
+)
+
+// newRequestedIPValidator returns a RequestedIPValidator of a Network whose
+// IPv4 addresses are assigned from the pool 192.168.1.10-19, in which
+// 192.168.1.11 is allocated to the NetworkInterface other.
+func newRequestedIPValidator(t *testing.T) *webhook.RequestedIPValidator {
+	pool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+	}
+	pool.SetAllocation("192.168.1.11", v1alpha1.NetworkInterfaceReference{Name: "other", Namespace: "ns", UID: "other-uid"})
+	objs := []runtime.Object{
+		pool,
+		&v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				IPPools:          []v1alpha1.IPPoolReference{{Name: "pool"}},
+				IPv6:             &v1alpha1.IPv6Config{IPAssignmentMode: v1alpha1.IPAssignmentModeSLAAC},
+			},
+		},
+		&v1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: "ns"},
+			Spec: v1alpha1.NetworkSpec{
+				Type: v1alpha1.NetworkTypeVDS,
+				ProviderRef: v1alpha1.NetworkProviderReference{
+					APIGroup: v1alpha1.GroupName,
+					Kind:     "VSphereDistributedNetwork",
+					Name:     "vdn",
+				},
+			},
+		},
+	}
+
+	scheme := newScheme(t)
+	v := &webhook.RequestedIPValidator{Client: fake.NewFakeClientWithScheme(scheme, objs...)}
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.InjectDecoder(decoder); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func requestingInterface(networkName string, ips ...string) *v1alpha1.NetworkInterface {
+	ni := &v1alpha1.NetworkInterface{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "NetworkInterface"},
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "ns", UID: "ni-uid"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: networkName},
+	}
+	for _, ip := range ips {
+		ni.Spec.RequestedIPConfigs = append(ni.Spec.RequestedIPConfigs, v1alpha1.RequestedIPConfig{IP: ip})
+	}
+	return ni
+}
+
+func admissionRequest(t *testing.T, op admissionv1beta1.Operation, obj, old runtime.Object) admission.Request {
+	req := admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{Operation: op}}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Object = runtime.RawExtension{Raw: raw}
+	if old != nil {
+		if req.OldObject.Raw, err = json.Marshal(old); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return req
+}
+
+func TestRequestedIPValidatorCreate(t *testing.T) {
+	v := newRequestedIPValidator(t)
+	tests := []struct {
+		name    string
+		ni      *v1alpha1.NetworkInterface
+		allowed bool
+		reason  string
+	}{
+		{"free address", requestingInterface("network", "192.168.1.10"), true, ""},
+		{"without requests", requestingInterface("network"), true, ""},
+		{"address outside the pools", requestingInterface("network", "192.168.2.10"), false, "not in pool"},
+		{"address of another interface", requestingInterface("network", "192.168.1.11"), false, "ns/other"},
+		{"address of a family not assigned from pools", requestingInterface("network", "fd00::10"), false, "not assigned from IPPools"},
+		{"missing network", requestingInterface("missing", "192.168.2.10"), true, ""},
+	}
+	for _, tt := range tests {
+		resp := v.Handle(context.Background(), admissionRequest(t, admissionv1beta1.Create, tt.ni, nil))
+		if resp.Allowed != tt.allowed {
+			t.Errorf("%s: Allowed = %v, want %v: %+v", tt.name, resp.Allowed, tt.allowed, resp.Result)
+			continue
+		}
+		if !tt.allowed {
+			if msg := string(resp.Result.Reason); !strings.Contains(msg, "spec.requestedIPConfigs[0].ip") ||
+				!strings.Contains(msg, tt.reason) {
+				t.Errorf("%s: denied with %q, want a message about spec.requestedIPConfigs[0].ip containing %q",
+					tt.name, msg, tt.reason)
+			}
+		}
+	}
+}
+
+func TestRequestedIPValidatorUpdate(t *testing.T) {
+	v := newRequestedIPValidator(t)
+
+	// Unchanged requests are not validated again, ex. when the pool of the
+	// address was removed from the network.
+	old := requestingInterface("network", "192.168.2.10")
+	ni := old.DeepCopy()
+	ni.Labels = map[string]string{"label": "value"}
+	if resp := v.Handle(context.Background(), admissionRequest(t, admissionv1beta1.Update, ni, old)); !resp.Allowed {
+		t.Errorf("update of the labels was denied: %s", resp.Result.Reason)
+	}
+
+	ni.Spec.RequestedIPConfigs[0].IP = "192.168.1.11"
+	if resp := v.Handle(context.Background(), admissionRequest(t, admissionv1beta1.Update, ni, old)); resp.Allowed {
+		t.Errorf("request of the address of another interface was allowed")
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook serves the admission and conversion webhooks for the
+// netoperator.vmware.com API group. The defaulting, validation and conversion
+// logic lives on the API types themselves, this package only registers it with
+// a controller-runtime webhook server. The RequestedIPValidator is the
+// exception, it validates NetworkInterfaces against the IPPools of their
+// network.
+package webhook
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+var (
+	_ admission.Defaulter = &v1alpha1.AviLoadBalancerConfig{}
+	_ admission.Defaulter = &v1alpha1.E1000ENetworkInterface{}
+	_ admission.Defaulter = &v1alpha1.HAProxyLoadBalancerConfig{}
+	_ admission.Defaulter = &v1alpha1.IPPool{}
+	_ admission.Defaulter = &v1alpha1.LoadBalancerConfig{}
+	_ admission.Defaulter = &v1alpha1.Network{}
+	_ admission.Defaulter = &v1alpha1.NetworkInterface{}
+	_ admission.Defaulter = &v1alpha1.NSXTNetwork{}
+	_ admission.Defaulter = &v1alpha1.SRIOVNetworkInterface{}
+	_ admission.Defaulter = &v1alpha1.VMXNET3NetworkInterface{}
+	_ admission.Defaulter = &v1alpha1.VSphereDistributedNetwork{}
+
+	_ admission.Validator = &v1alpha1.AviLoadBalancerConfig{}
+	_ admission.Validator = &v1alpha1.E1000ENetworkInterface{}
+	_ admission.Validator = &v1alpha1.HAProxyLoadBalancerConfig{}
+	_ admission.Validator = &v1alpha1.IPPool{}
+	_ admission.Validator = &v1alpha1.LoadBalancerConfig{}
+	_ admission.Validator = &v1alpha1.Network{}
+	_ admission.Validator = &v1alpha1.NetworkInterface{}
+	_ admission.Validator = &v1alpha1.NSXTNetwork{}
+	_ admission.Validator = &v1alpha1.SRIOVNetworkInterface{}
+	_ admission.Validator = &v1alpha1.VMXNET3NetworkInterface{}
+	_ admission.Validator = &v1alpha1.VSphereDistributedNetwork{}
+)
+
+// Defaulters returns an empty object of every kind that has a mutating
+// webhook.
+func Defaulters() []admission.Defaulter {
+	return []admission.Defaulter{
+		&v1alpha1.AviLoadBalancerConfig{},
+		&v1alpha1.E1000ENetworkInterface{},
+		&v1alpha1.HAProxyLoadBalancerConfig{},
+		&v1alpha1.IPPool{},
+		&v1alpha1.LoadBalancerConfig{},
+		&v1alpha1.Network{},
+		&v1alpha1.NetworkInterface{},
+		&v1alpha1.NSXTNetwork{},
+		&v1alpha1.SRIOVNetworkInterface{},
+		&v1alpha1.VMXNET3NetworkInterface{},
+		&v1alpha1.VSphereDistributedNetwork{},
+	}
+}
+
+// Validators returns an empty object of every kind that has a validating
+// webhook.
+func Validators() []admission.Validator {
+	return []admission.Validator{
+		&v1alpha1.AviLoadBalancerConfig{},
+		&v1alpha1.E1000ENetworkInterface{},
+		&v1alpha1.HAProxyLoadBalancerConfig{},
+		&v1alpha1.IPPool{},
+		&v1alpha1.LoadBalancerConfig{},
+		&v1alpha1.Network{},
+		&v1alpha1.NetworkInterface{},
+		&v1alpha1.NSXTNetwork{},
+		&v1alpha1.SRIOVNetworkInterface{},
+		&v1alpha1.VMXNET3NetworkInterface{},
+		&v1alpha1.VSphereDistributedNetwork{},
+	}
+}
+
+// ConvertPath is the path at which the conversion webhook is served.
+const ConvertPath = "/convert"
+
+// AddToManager registers the webhooks of all kinds, and the
+// RequestedIPValidator, with the webhook server of the given manager. The
+// manager's scheme must include v1alpha1, and must also include v1alpha2 for
+// the conversion webhook to be registered.
+func AddToManager(mgr manager.Manager) error {
+	// The builder registers the mutating, validating and conversion webhooks
+	// of each kind, and every kind with one admission webhook has the other.
+	for _, obj := range Validators() {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(obj).Complete(); err != nil {
+			return err
+		}
+	}
+	mgr.GetWebhookServer().Register(ValidateRequestedIPsPath, &webhook.Admission{
+		Handler: &RequestedIPValidator{Client: mgr.GetAPIReader()},
+	})
+	return nil
+}
+
+// AddToServer registers the webhooks of all kinds, and the
+// RequestedIPValidator reading from the given client, with the given webhook
+// server. Use this instead of AddToManager when the server is not run by a
+// manager, ex. when serving the webhooks for envtest. The scheme must include
+// v1alpha1 and v1alpha2.
+func AddToServer(srv *webhook.Server, scheme *runtime.Scheme, c client.Reader) error {
+	for _, obj := range Defaulters() {
+		if err := register(srv, scheme, obj, MutatePath, admission.DefaultingWebhookFor(obj)); err != nil {
+			return err
+		}
+	}
+	for _, obj := range Validators() {
+		if err := register(srv, scheme, obj, ValidatePath, admission.ValidatingWebhookFor(obj)); err != nil {
+			return err
+		}
+	}
+
+	requestedIPs := &webhook.Admission{Handler: &RequestedIPValidator{Client: c}}
+	validateRequestedIPsPath := func(schema.GroupVersionKind) string { return ValidateRequestedIPsPath }
+	if err := register(srv, scheme, &v1alpha1.NetworkInterface{}, validateRequestedIPsPath, requestedIPs); err != nil {
+		return err
+	}
+
+	wh := &conversion.Webhook{}
+	if err := wh.InjectScheme(scheme); err != nil {
+		return err
+	}
+	srv.Register(ConvertPath, wh)
+	return nil
+}
+
+func register(
+	srv *webhook.Server,
+	scheme *runtime.Scheme,
+	obj runtime.Object,
+	path func(schema.GroupVersionKind) string,
+	wh *admission.Webhook) error {
+
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return err
+	}
+	if err := wh.InjectScheme(scheme); err != nil {
+		return err
+	}
+	// The server injects its logger when it starts, inject one now so that
+	// the webhook can also be served by the WebhookMux of the server alone.
+	if err := wh.InjectLogger(log.Log.WithName("webhooks").WithValues("webhook", path(gvk))); err != nil {
+		return err
+	}
+	srv.Register(path(gvk), wh)
+	return nil
+}
+
+// MutatePath returns the path at which the mutating webhook for the given
+// kind is served. It matches the path used by the controller-runtime webhook
+// builder.
+func MutatePath(gvk schema.GroupVersionKind) string {
+	return "/mutate-" + pathSuffix(gvk)
+}
+
+// ValidatePath returns the path at which the validating webhook for the given
+// kind is served. It matches the path used by the controller-runtime webhook
+// builder.
+func ValidatePath(gvk schema.GroupVersionKind) string {
+	return "/validate-" + pathSuffix(gvk)
+}
+
+func pathSuffix(gvk schema.GroupVersionKind) string {
+	return strings.Replace(gvk.Group, ".", "-", -1) + "-" + gvk.Version + "-" + strings.ToLower(gvk.Kind)
+}
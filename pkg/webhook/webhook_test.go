@@ -0,0 +1,241 @@
+// Copyright (c) 2020 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/internal/testenv"
+	"github.com/vmware-tanzu/net-operator-api/pkg/webhook"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+// newServer returns an HTTP server serving the webhooks registered by
+// AddToServer.
+func newServer(t *testing.T) *httptest.Server {
+	srv := &ctrlwebhook.Server{}
+	if err := webhook.AddToServer(srv, newScheme(t), fake.NewFakeClientWithScheme(newScheme(t))); err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewServer(srv.WebhookMux)
+}
+
+// review posts an AdmissionReview creating obj to the given path and returns
+// the response.
+func review(t *testing.T, srv *httptest.Server, path string, obj runtime.Object) *admissionv1beta1.AdmissionResponse {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ar := admissionv1beta1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionv1beta1.SchemeGroupVersion.String(),
+			Kind:       "AdmissionReview",
+		},
+		Request: &admissionv1beta1.AdmissionRequest{
+			UID:       "test",
+			Operation: admissionv1beta1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+	body, err := json.Marshal(ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(srv.URL+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	ar = admissionv1beta1.AdmissionReview{}
+	if err := json.NewDecoder(resp.Body).Decode(&ar); err != nil {
+		t.Fatal(err)
+	}
+	if ar.Response == nil {
+		t.Fatalf("POST %s returned no response", path)
+	}
+	return ar.Response
+}
+
+func TestAddToServer(t *testing.T) {
+	scheme := newScheme(t)
+	srv := &ctrlwebhook.Server{}
+	if err := webhook.AddToServer(srv, scheme, fake.NewFakeClientWithScheme(scheme)); err != nil {
+		t.Fatal(err)
+	}
+
+	paths := []string{webhook.ConvertPath, webhook.ValidateRequestedIPsPath}
+	for _, obj := range webhook.Defaulters() {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, webhook.MutatePath(gvk))
+	}
+	for _, obj := range webhook.Validators() {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, webhook.ValidatePath(gvk))
+	}
+	for _, path := range paths {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		if _, pattern := srv.WebhookMux.Handler(req); pattern != path {
+			t.Errorf("no webhook registered at %s", path)
+		}
+	}
+}
+
+func TestValidatingWebhook(t *testing.T) {
+	srv := newServer(t)
+	defer srv.Close()
+
+	path := "/validate-netoperator-vmware-com-v1alpha1-aviloadbalancerconfig"
+	config := &v1alpha1.AviLoadBalancerConfig{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "AviLoadBalancerConfig"},
+		ObjectMeta: metav1.ObjectMeta{Name: "avi"},
+		Spec: v1alpha1.AviLoadBalancerConfigSpec{
+			Server:              "avi.example.com:",
+			CredentialSecretRef: v1alpha1.ClientSecretReference{Name: "avi-credentials"},
+		},
+	}
+	if resp := review(t, srv, path, config); resp.Allowed {
+		t.Errorf("server %q was allowed", config.Spec.Server)
+	} else if msg := string(resp.Result.Reason); !strings.Contains(msg, "spec.server") {
+		t.Errorf("denied with %q, want a message about spec.server", msg)
+	}
+
+	config.Spec.Server = "avi.example.com:443"
+	if resp := review(t, srv, path, config); !resp.Allowed {
+		t.Errorf("server %q was denied: %s", config.Spec.Server, resp.Result.Reason)
+	}
+}
+
+func TestMutatingWebhook(t *testing.T) {
+	srv := newServer(t)
+	defer srv.Close()
+
+	ni := &v1alpha1.NetworkInterface{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "NetworkInterface"},
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "default"},
+		Spec:       v1alpha1.NetworkInterfaceSpec{NetworkName: "network"},
+	}
+	resp := review(t, srv, "/mutate-netoperator-vmware-com-v1alpha1-networkinterface", ni)
+	if !resp.Allowed {
+		t.Fatalf("denied: %s", resp.Result.Reason)
+	}
+	if patch := string(resp.Patch); !strings.Contains(patch, `"/spec/type"`) {
+		t.Errorf("patch %s does not default spec.type", patch)
+	}
+}
+
+func TestWebhooksEnvtest(t *testing.T) {
+	env := testenv.Start(t, testenv.Options{Webhooks: true})
+	defer env.Stop(t)
+	ctx := context.Background()
+
+	config := &v1alpha1.AviLoadBalancerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "avi"},
+		Spec: v1alpha1.AviLoadBalancerConfigSpec{
+			Server:              "avi.example.com:",
+			CredentialSecretRef: v1alpha1.ClientSecretReference{Name: "avi-credentials"},
+		},
+	}
+	if err := env.Client.Create(ctx, config); err == nil || !strings.Contains(err.Error(), "spec.server") {
+		t.Errorf("Create() with server %q = %v, want an error about spec.server", config.Spec.Server, err)
+	}
+
+	config.Spec.Server = "https://avi.example.com"
+	if err := env.Client.Create(ctx, config); err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	created := &v1alpha1.AviLoadBalancerConfig{}
+	if err := env.Client.Get(ctx, client.ObjectKey{Name: "avi"}, created); err != nil {
+		t.Fatal(err)
+	}
+	if created.Spec.LogLevel != v1alpha1.AviLoadBalancerLogLevelWarn {
+		t.Errorf("LogLevel = %q, want the default %q", created.Spec.LogLevel, v1alpha1.AviLoadBalancerLogLevelWarn)
+	}
+
+	pool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: "255.255.255.0", AddressCount: 257},
+	}
+	if err := env.Client.Create(ctx, pool); err == nil || !strings.Contains(err.Error(), "spec.addressCount") {
+		t.Errorf("Create() of an IPPool exceeding the IPv4 address space = %v, want an error about spec.addressCount", err)
+	}
+
+	for _, obj := range []runtime.Object{
+		&v1alpha1.IPPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "network-pool"},
+			Spec:       v1alpha1.IPPoolSpec{StartingAddress: "192.168.1.10", AddressCount: 10},
+		},
+		&v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vdn"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				IPPools:          []v1alpha1.IPPoolReference{{Name: "network-pool"}},
+			},
+		},
+		&v1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: "default"},
+			Spec: v1alpha1.NetworkSpec{
+				Type: v1alpha1.NetworkTypeVDS,
+				ProviderRef: v1alpha1.NetworkProviderReference{
+					APIGroup: v1alpha1.GroupName,
+					Kind:     "VSphereDistributedNetwork",
+					Name:     "vdn",
+				},
+			},
+		},
+	} {
+		if err := env.Client.Create(ctx, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ni := &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: "ni", Namespace: "default"},
+		Spec: v1alpha1.NetworkInterfaceSpec{
+			NetworkName:        "network",
+			RequestedIPConfigs: []v1alpha1.RequestedIPConfig{{IP: "192.168.2.10"}},
+		},
+	}
+	if err := env.Client.Create(ctx, ni); err == nil || !strings.Contains(err.Error(), "spec.requestedIPConfigs[0].ip") {
+		t.Errorf("Create() of a NetworkInterface requesting an address outside the pools = %v, "+
+			"want an error about spec.requestedIPConfigs[0].ip", err)
+	}
+	ni.Spec.RequestedIPConfigs[0].IP = "192.168.1.10"
+	if err := env.Client.Create(ctx, ni); err != nil {
+		t.Errorf("Create() of a NetworkInterface requesting a free address = %v", err)
+	}
+}